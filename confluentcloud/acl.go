@@ -0,0 +1,263 @@
+package confluentcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ACLResourceType is the kind of resource an ACL applies to.
+type ACLResourceType string
+
+const (
+	ACLResourceTopic           ACLResourceType = "TOPIC"
+	ACLResourceGroup           ACLResourceType = "GROUP"
+	ACLResourceCluster         ACLResourceType = "CLUSTER"
+	ACLResourceTransactionalID ACLResourceType = "TRANSACTIONAL_ID"
+	// ACLResourceAny matches every resource type in an ACLFilter.
+	ACLResourceAny ACLResourceType = "ANY"
+)
+
+func (t ACLResourceType) valid() bool {
+	switch t {
+	case ACLResourceTopic, ACLResourceGroup, ACLResourceCluster, ACLResourceTransactionalID:
+		return true
+	}
+	return false
+}
+
+// ACLPatternType tells how the resource name of an ACL is matched.
+type ACLPatternType string
+
+const (
+	// ACLPatternLiteral matches the resource with exactly the given name,
+	// or every resource if the name is "*".
+	ACLPatternLiteral ACLPatternType = "LITERAL"
+	// ACLPatternPrefixed matches the resources whose name starts with the
+	// given name.
+	ACLPatternPrefixed ACLPatternType = "PREFIXED"
+	// ACLPatternAny matches ACLs of every pattern type in an ACLFilter.
+	ACLPatternAny ACLPatternType = "ANY"
+	// ACLPatternMatch matches, in an ACLFilter, the ACLs that apply to the
+	// named resource: literal, wildcard and prefixed ones.
+	ACLPatternMatch ACLPatternType = "MATCH"
+)
+
+func (t ACLPatternType) valid() bool {
+	return t == ACLPatternLiteral || t == ACLPatternPrefixed
+}
+
+// ACLOperation is the operation an ACL allows or denies.
+type ACLOperation string
+
+const (
+	ACLOperationAll             ACLOperation = "ALL"
+	ACLOperationRead            ACLOperation = "READ"
+	ACLOperationWrite           ACLOperation = "WRITE"
+	ACLOperationCreate          ACLOperation = "CREATE"
+	ACLOperationDelete          ACLOperation = "DELETE"
+	ACLOperationAlter           ACLOperation = "ALTER"
+	ACLOperationDescribe        ACLOperation = "DESCRIBE"
+	ACLOperationClusterAction   ACLOperation = "CLUSTER_ACTION"
+	ACLOperationDescribeConfigs ACLOperation = "DESCRIBE_CONFIGS"
+	ACLOperationAlterConfigs    ACLOperation = "ALTER_CONFIGS"
+	ACLOperationIdempotentWrite ACLOperation = "IDEMPOTENT_WRITE"
+	// ACLOperationAny matches every operation in an ACLFilter.
+	ACLOperationAny ACLOperation = "ANY"
+)
+
+func (o ACLOperation) valid() bool {
+	switch o {
+	case ACLOperationAll, ACLOperationRead, ACLOperationWrite, ACLOperationCreate,
+		ACLOperationDelete, ACLOperationAlter, ACLOperationDescribe, ACLOperationClusterAction,
+		ACLOperationDescribeConfigs, ACLOperationAlterConfigs, ACLOperationIdempotentWrite:
+		return true
+	}
+	return false
+}
+
+// ACLPermission tells whether an ACL allows or denies its operation.
+type ACLPermission string
+
+const (
+	ACLPermissionAllow ACLPermission = "ALLOW"
+	ACLPermissionDeny  ACLPermission = "DENY"
+	// ACLPermissionAny matches both permissions in an ACLFilter.
+	ACLPermissionAny ACLPermission = "ANY"
+)
+
+// ACLClusterResourceName is the resource name of ACLs on the cluster itself.
+const ACLClusterResourceName = "kafka-cluster"
+
+// ServiceAccountPrincipal returns the ACL principal of the service account
+// with the given ID, e.g. User:12345.
+func ServiceAccountPrincipal(id int) string {
+	return "User:" + strconv.Itoa(id)
+}
+
+// ACLBinding grants or denies a principal an operation on the resources
+// matched by a resource type, name and pattern type.
+type ACLBinding struct {
+	ResourceType ACLResourceType `json:"resource_type"`
+	ResourceName string          `json:"resource_name"`
+	PatternType  ACLPatternType  `json:"pattern_type"`
+	// Principal is the user the ACL applies to; see
+	// ServiceAccountPrincipal.
+	Principal string `json:"principal"`
+	// Host restricts the ACL to clients connecting from a host. An empty
+	// Host is sent as "*", which matches every host.
+	Host       string        `json:"host"`
+	Operation  ACLOperation  `json:"operation"`
+	Permission ACLPermission `json:"permission"`
+}
+
+type ACLsResponse struct {
+	Data []ACLBinding `json:"data"`
+}
+
+// Validate checks the binding for mistakes the API would reject, so that
+// they surface before CreateACL sends the request.
+func (b ACLBinding) Validate() error {
+	if !b.ResourceType.valid() {
+		return &ValidationError{Field: "resource_type", Reason: fmt.Sprintf("unknown resource type %q", b.ResourceType)}
+	}
+	if b.ResourceName == "" {
+		return &ValidationError{Field: "resource_name", Reason: "must not be empty"}
+	}
+	if b.ResourceType == ACLResourceCluster && b.ResourceName != ACLClusterResourceName {
+		return &ValidationError{Field: "resource_name", Reason: fmt.Sprintf("must be %q for cluster ACLs", ACLClusterResourceName)}
+	}
+	if !b.PatternType.valid() {
+		return &ValidationError{Field: "pattern_type", Reason: fmt.Sprintf("unknown pattern type %q", b.PatternType)}
+	}
+	if b.Principal == "" {
+		return &ValidationError{Field: "principal", Reason: "must not be empty"}
+	}
+	if !b.Operation.valid() {
+		return &ValidationError{Field: "operation", Reason: fmt.Sprintf("unknown operation %q", b.Operation)}
+	}
+	if b.Permission != ACLPermissionAllow && b.Permission != ACLPermissionDeny {
+		return &ValidationError{Field: "permission", Reason: fmt.Sprintf("unknown permission %q", b.Permission)}
+	}
+	return nil
+}
+
+// ACLFilter selects ACL bindings. Empty fields match every value.
+type ACLFilter struct {
+	ResourceType ACLResourceType
+	ResourceName string
+	PatternType  ACLPatternType
+	Principal    string
+	Host         string
+	Operation    ACLOperation
+	Permission   ACLPermission
+}
+
+func (f ACLFilter) params() map[string]string {
+	params := map[string]string{
+		"resource_type": string(f.ResourceType),
+		"resource_name": f.ResourceName,
+		"pattern_type":  string(f.PatternType),
+		"principal":     f.Principal,
+		"host":          f.Host,
+		"operation":     string(f.Operation),
+		"permission":    string(f.Permission),
+	}
+	for name, value := range params {
+		if value == "" {
+			delete(params, name)
+		}
+	}
+	return params
+}
+
+func (k *KafkaClient) CreateACL(binding ACLBinding) error {
+	return k.CreateACLContext(context.Background(), binding)
+}
+
+func (k *KafkaClient) CreateACLContext(ctx context.Context, binding ACLBinding) error {
+	if err := binding.Validate(); err != nil {
+		return err
+	}
+	if binding.Host == "" {
+		binding.Host = "*"
+	}
+
+	u, err := k.url("acls")
+	if err != nil {
+		return err
+	}
+
+	response, err := k.NewRequest(ctx).
+		SetBody(&binding).
+		Post(u)
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError("create acl", response)
+	}
+
+	return nil
+}
+
+// ListACLs returns the ACL bindings matched by filter.
+func (k *KafkaClient) ListACLs(filter ACLFilter) ([]ACLBinding, error) {
+	return k.ListACLsContext(context.Background(), filter)
+}
+
+func (k *KafkaClient) ListACLsContext(ctx context.Context, filter ACLFilter) ([]ACLBinding, error) {
+	u, err := k.url("acls")
+	if err != nil {
+		return []ACLBinding{}, err
+	}
+
+	response, err := k.NewRequest(ctx).
+		SetQueryParams(filter.params()).
+		SetResult(&ACLsResponse{}).
+		Get(u)
+
+	if err != nil {
+		return []ACLBinding{}, err
+	}
+
+	if response.IsError() {
+		return []ACLBinding{}, newAPIError("list acls", response)
+	}
+
+	return response.Result().(*ACLsResponse).Data, nil
+}
+
+// DeleteACLs deletes the ACL bindings matched by filter and returns them.
+// The API requires the filter to name a resource type.
+func (k *KafkaClient) DeleteACLs(filter ACLFilter) ([]ACLBinding, error) {
+	return k.DeleteACLsContext(context.Background(), filter)
+}
+
+func (k *KafkaClient) DeleteACLsContext(ctx context.Context, filter ACLFilter) ([]ACLBinding, error) {
+	if filter.ResourceType == "" {
+		return []ACLBinding{}, &ValidationError{Field: "resource_type", Reason: "must not be empty"}
+	}
+
+	u, err := k.url("acls")
+	if err != nil {
+		return []ACLBinding{}, err
+	}
+
+	response, err := k.NewRequest(ctx).
+		SetQueryParams(filter.params()).
+		SetResult(&ACLsResponse{}).
+		Delete(u)
+
+	if err != nil {
+		return []ACLBinding{}, err
+	}
+
+	if response.IsError() {
+		return []ACLBinding{}, newAPIError("delete acls", response)
+	}
+
+	return response.Result().(*ACLsResponse).Data, nil
+}
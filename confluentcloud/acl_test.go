@@ -0,0 +1,176 @@
+package confluentcloud_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/confluentcloudtest"
+)
+
+func TestACLBindingValidate(t *testing.T) {
+	base := confluentcloud.ACLBinding{
+		ResourceType: confluentcloud.ACLResourceTopic,
+		ResourceName: "orders",
+		PatternType:  confluentcloud.ACLPatternLiteral,
+		Principal:    confluentcloud.ServiceAccountPrincipal(1),
+		Operation:    confluentcloud.ACLOperationRead,
+		Permission:   confluentcloud.ACLPermissionAllow,
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*confluentcloud.ACLBinding)
+		wantErr string
+	}{
+		{"valid", func(b *confluentcloud.ACLBinding) {}, ""},
+		{"cluster", func(b *confluentcloud.ACLBinding) {
+			b.ResourceType = confluentcloud.ACLResourceCluster
+			b.ResourceName = confluentcloud.ACLClusterResourceName
+		}, ""},
+		{"any resource type", func(b *confluentcloud.ACLBinding) { b.ResourceType = confluentcloud.ACLResourceAny }, "resource_type"},
+		{"empty resource name", func(b *confluentcloud.ACLBinding) { b.ResourceName = "" }, "resource_name"},
+		{"cluster with topic name", func(b *confluentcloud.ACLBinding) { b.ResourceType = confluentcloud.ACLResourceCluster }, "resource_name"},
+		{"match pattern", func(b *confluentcloud.ACLBinding) { b.PatternType = confluentcloud.ACLPatternMatch }, "pattern_type"},
+		{"empty principal", func(b *confluentcloud.ACLBinding) { b.Principal = "" }, "principal"},
+		{"unknown operation", func(b *confluentcloud.ACLBinding) { b.Operation = "PUBLISH" }, "operation"},
+		{"any permission", func(b *confluentcloud.ACLBinding) { b.Permission = confluentcloud.ACLPermissionAny }, "permission"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := base
+			tt.modify(&b)
+			err := b.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got %v, want no error", err)
+				}
+				return
+			}
+			if !isValidationError(err, tt.wantErr) {
+				t.Errorf("got %v, want a validation error for %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestACLFilterQuery(t *testing.T) {
+	var query url.Values
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer srv.Close()
+
+	c := confluentcloud.NewClientWithAPIKey("key", "secret")
+	k, err := c.NewKafkaClient(
+		&confluentcloud.Cluster{ID: "lkc-1", APIEndpoint: srv.URL},
+		&confluentcloud.APIKey{Key: "key", Secret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := k.ListACLs(confluentcloud.ACLFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(query) != 0 {
+		t.Errorf("got query %v for an empty filter, want none", query)
+	}
+
+	_, err = k.ListACLs(confluentcloud.ACLFilter{
+		ResourceType: confluentcloud.ACLResourceTopic,
+		ResourceName: "orders",
+		PatternType:  confluentcloud.ACLPatternMatch,
+		Principal:    "User:1",
+		Operation:    confluentcloud.ACLOperationAny,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := url.Values{
+		"resource_type": {"TOPIC"},
+		"resource_name": {"orders"},
+		"pattern_type":  {"MATCH"},
+		"principal":     {"User:1"},
+		"operation":     {"ANY"},
+	}
+	if !reflect.DeepEqual(query, want) {
+		t.Errorf("got query %v, want %v", query, want)
+	}
+
+	if _, err := k.DeleteACLs(confluentcloud.ACLFilter{Principal: "User:1"}); !isValidationError(err, "resource_type") {
+		t.Errorf("got %v deleting without a resource type, want a validation error for resource_type", err)
+	}
+	if requests != 2 {
+		t.Errorf("got %d requests, want the delete to be rejected before sending", requests)
+	}
+}
+
+func TestACLs(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	k := newKafkaClient(t, srv)
+
+	read := confluentcloud.ACLBinding{
+		ResourceType: confluentcloud.ACLResourceTopic,
+		ResourceName: "orders",
+		PatternType:  confluentcloud.ACLPatternLiteral,
+		Principal:    "User:1",
+		Operation:    confluentcloud.ACLOperationRead,
+		Permission:   confluentcloud.ACLPermissionAllow,
+	}
+	write := read
+	write.ResourceName = "ord"
+	write.PatternType = confluentcloud.ACLPatternPrefixed
+	write.Operation = confluentcloud.ACLOperationWrite
+	group := read
+	group.ResourceType = confluentcloud.ACLResourceGroup
+	group.ResourceName = "*"
+	for _, binding := range []confluentcloud.ACLBinding{read, write, group} {
+		if err := k.CreateACL(binding); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := k.ListACLs(confluentcloud.ACLFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 || all[0].Host != "*" {
+		t.Errorf("got ACLs %+v, want 3 for every host", all)
+	}
+
+	matched, err := k.ListACLs(confluentcloud.ACLFilter{
+		ResourceType: confluentcloud.ACLResourceTopic,
+		ResourceName: "orders",
+		PatternType:  confluentcloud.ACLPatternMatch,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matched) != 2 {
+		t.Errorf("got ACLs %+v for topic orders, want the literal and the prefixed one", matched)
+	}
+
+	deleted, err := k.DeleteACLs(confluentcloud.ACLFilter{ResourceType: confluentcloud.ACLResourceTopic})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 2 {
+		t.Errorf("got deleted ACLs %+v, want the two topic ACLs", deleted)
+	}
+	left, err := k.ListACLs(confluentcloud.ACLFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) != 1 || left[0].ResourceType != confluentcloud.ACLResourceGroup {
+		t.Errorf("got ACLs %+v after delete, want the group ACL", left)
+	}
+}
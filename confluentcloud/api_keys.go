@@ -1,8 +1,8 @@
 package confluentcloud
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net/url"
 )
 
@@ -44,6 +44,10 @@ type ApiKeyCreateRequest struct {
 }
 
 func (c *Client) CreateAPIKey(request *ApiKeyCreateRequest) (*APIKey, error) {
+	return c.CreateAPIKeyContext(context.Background(), request)
+}
+
+func (c *Client) CreateAPIKeyContext(ctx context.Context, request *ApiKeyCreateRequest) (*APIKey, error) {
 	rel, err := url.Parse("api_keys")
 	if err != nil {
 		return nil, err
@@ -52,9 +56,9 @@ func (c *Client) CreateAPIKey(request *ApiKeyCreateRequest) (*APIKey, error) {
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(&ApiKeyCreateRequestW{APIKey: request}).
 		SetResult(&APIKeyResponse{}).
-		SetError(&ErrorResponse{}).
 		Post(u.String())
 
 	if err != nil {
@@ -62,13 +66,17 @@ func (c *Client) CreateAPIKey(request *ApiKeyCreateRequest) (*APIKey, error) {
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("api_keys: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("api_keys", response)
 	}
 
 	return &response.Result().(*APIKeyResponse).APIKey, nil
 }
 
 func (c *Client) DeleteAPIKey(id, account_id string, logical_clusters []LogicalCluster) error {
+	return c.DeleteAPIKeyContext(context.Background(), id, account_id, logical_clusters)
+}
+
+func (c *Client) DeleteAPIKeyContext(ctx context.Context, id, account_id string, logical_clusters []LogicalCluster) error {
 	rel, err := url.Parse(fmt.Sprintf("api_keys/%s", id))
 	if err != nil {
 		return err
@@ -77,6 +85,7 @@ func (c *Client) DeleteAPIKey(id, account_id string, logical_clusters []LogicalC
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(
 			map[string]interface{}{
 				"api_key": map[string]interface{}{
@@ -86,7 +95,6 @@ func (c *Client) DeleteAPIKey(id, account_id string, logical_clusters []LogicalC
 				},
 			},
 		).
-		SetError(&ErrorResponse{}).
 		Delete(u.String())
 
 	if err != nil {
@@ -94,14 +102,18 @@ func (c *Client) DeleteAPIKey(id, account_id string, logical_clusters []LogicalC
 	}
 
 	if response.IsError() {
-		return fmt.Errorf("delete api key: %s", response.Error().(*ErrorResponse).Error.Message)
+		return newAPIError("delete api key", response)
 	}
 
-	log.Printf("[DEBUG] DeleteAPIKey Success(%s, %s)", id, account_id)
+	c.logger.Debug("api key deleted", "id", id, "account_id", account_id)
 	return nil
 }
 
 func (c *Client) ListAPIKeys(clusterID, accountID string) ([]APIKey, error) {
+	return c.ListAPIKeysContext(context.Background(), clusterID, accountID)
+}
+
+func (c *Client) ListAPIKeysContext(ctx context.Context, clusterID, accountID string) ([]APIKey, error) {
 	rel, err := url.Parse("api_keys")
 	if err != nil {
 		return []APIKey{}, err
@@ -109,10 +121,10 @@ func (c *Client) ListAPIKeys(clusterID, accountID string) ([]APIKey, error) {
 
 	u := c.BaseURL.ResolveReference(rel)
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetQueryParam("account_id", accountID).
 		SetQueryParam("cluster_id", clusterID).
 		SetResult(&APIKeysResponse{}).
-		SetError(&ErrorResponse{}).
 		Get(u.String())
 
 	if err != nil {
@@ -120,7 +132,43 @@ func (c *Client) ListAPIKeys(clusterID, accountID string) ([]APIKey, error) {
 	}
 
 	if response.IsError() {
-		return []APIKey{}, fmt.Errorf("clusters: %s", response.Error().(*ErrorResponse).Error.Message)
+		return []APIKey{}, newAPIError("api_keys", response)
 	}
 	return response.Result().(*APIKeysResponse).APIKeys, nil
 }
+
+// APIKeyIterator iterates over the API keys of a cluster. The v1 API returns the
+// complete list, which is requested by the first call to Next.
+type APIKeyIterator struct {
+	pager
+	page    []APIKey
+	current APIKey
+}
+
+// Next advances to the next API key. It returns false at the end of the
+// list or when the request failed; check Err to tell them apart.
+func (it *APIKeyIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// APIKey returns the API key Next advanced to.
+func (it *APIKeyIterator) APIKey() APIKey {
+	return it.current
+}
+
+// IterateAPIKeys returns an iterator over the API keys of a cluster.
+func (c *Client) IterateAPIKeys(ctx context.Context, clusterID, accountID string) *APIKeyIterator {
+	it := &APIKeyIterator{}
+	it.pager = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		var err error
+		it.page, err = c.ListAPIKeysContext(ctx, clusterID, accountID)
+		return "", err
+	}}
+	return it
+}
@@ -0,0 +1,87 @@
+package confluentcloud
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const authCookieName = "auth_token"
+
+// Authenticator adds credentials to an outgoing API request.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// Refresher is implemented by authenticators whose credentials can be renewed
+// after the API rejected a request as unauthorized. The request is replayed
+// once after a successful Refresh.
+type Refresher interface {
+	Refresh(rejected *http.Request) error
+}
+
+// AuthenticatorFunc adapts an ordinary function to the Authenticator interface.
+type AuthenticatorFunc func(req *http.Request) error
+
+// Authenticate calls f(req).
+func (f AuthenticatorFunc) Authenticate(req *http.Request) error {
+	return f(req)
+}
+
+// SessionAuthenticator sends the session token of a Client, both as a bearer
+// token and as the auth cookie. Requests are sent unauthenticated until the
+// Client has logged in. When the API rejects the token, the Client logs in
+// again with its email and password.
+type SessionAuthenticator struct {
+	client *Client
+}
+
+// Authenticate adds the current session token to req.
+func (a *SessionAuthenticator) Authenticate(req *http.Request) error {
+	token := a.client.Token()
+	if token == "" {
+		return nil
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.AddCookie(&http.Cookie{Name: authCookieName, Value: token})
+	return nil
+}
+
+// Refresh logs in again unless the token rejected with the request has already
+// been replaced by a concurrent refresh.
+func (a *SessionAuthenticator) Refresh(rejected *http.Request) error {
+	stale := strings.TrimPrefix(rejected.Header.Get("Authorization"), "Bearer ")
+	return a.client.refreshSession(rejected.Context(), stale)
+}
+
+// APIKeyAuthenticator authenticates requests with a Cloud API key and secret
+// using HTTP basic authentication.
+type APIKeyAuthenticator struct {
+	Key    string
+	Secret string
+}
+
+// Authenticate adds the API key and secret to req.
+func (a *APIKeyAuthenticator) Authenticate(req *http.Request) error {
+	req.SetBasicAuth(a.Key, a.Secret)
+	return nil
+}
+
+type authenticatorKey struct{}
+
+type authenticatorOverride struct {
+	auth Authenticator
+}
+
+// withAuthenticator returns a context that makes the transport authenticate
+// requests with auth instead of Client.Authenticator. A nil auth sends the
+// requests unauthenticated.
+func withAuthenticator(ctx context.Context, auth Authenticator) context.Context {
+	return context.WithValue(ctx, authenticatorKey{}, authenticatorOverride{auth: auth})
+}
+
+func authenticatorFromContext(ctx context.Context) (Authenticator, bool) {
+	override, ok := ctx.Value(authenticatorKey{}).(authenticatorOverride)
+	return override.auth, ok
+}
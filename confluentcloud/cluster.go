@@ -1,8 +1,8 @@
 package confluentcloud
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net/url"
 	"time"
 )
@@ -12,10 +12,18 @@ type ClustersResponse struct {
 }
 
 type ClusterCreateDeploymentConfig struct {
-	Sku       string `json:"sku"`
-	AccountID string `json:"account_id"`
+	Sku       ClusterSku `json:"sku"`
+	AccountID string     `json:"account_id"`
+	// NetworkAccess configures how a dedicated cluster is reached. It
+	// defaults to the public internet.
+	NetworkAccess *ClusterDeploymentNetworkAccess `json:"network_access,omitempty"`
 }
 
+// ClusterCreateConfig is the configuration of a new cluster.
+//
+// ServiceProvider, Durability and Deployment.Sku have their own string
+// types. Constants and string literals can be assigned to them as before,
+// but string variables need a conversion, e.g. ServiceProvider(provider).
 type ClusterCreateConfig struct {
 	Name            string                        `json:"name"`
 	AccountID       string                        `json:"accountId"`
@@ -23,8 +31,8 @@ type ClusterCreateConfig struct {
 	NetworkIngress  int                           `json:"network_ingress"`
 	NetworkEgress   int                           `json:"network_egress"`
 	Region          string                        `json:"region"`
-	ServiceProvider string                        `json:"serviceProvider"`
-	Durability      string                        `json:"durability"`
+	ServiceProvider ServiceProvider               `json:"serviceProvider"`
+	Durability      Durability                    `json:"durability"`
 	Deployment      ClusterCreateDeploymentConfig `json:"deployment"`
 	Cku             int                           `json:"cku"`
 }
@@ -33,13 +41,6 @@ type ClusterCreateRequest struct {
 	Config ClusterCreateConfig `json:"config"`
 }
 
-type ClusterDeploymentNetworkAccess struct {
-	PublicInternet []interface{} `json:"public_internet"`
-	VpcPeering     []interface{} `json:"vpc_peering"`
-	PrivateLink    []interface{} `json:"private_link"`
-	TransitGateway []interface{} `json:"transit_gateway"`
-}
-
 type ClusterDeployment struct {
 	ID            string                         `json:"id"`
 	Created       time.Time                      `json:"created"`
@@ -86,6 +87,10 @@ type ClusterResponse struct {
 }
 
 func (c *Client) ListClusters(accountID string) ([]Cluster, error) {
+	return c.ListClustersContext(context.Background(), accountID)
+}
+
+func (c *Client) ListClustersContext(ctx context.Context, accountID string) ([]Cluster, error) {
 	rel, err := url.Parse("clusters")
 	if err != nil {
 		return []Cluster{}, err
@@ -93,9 +98,9 @@ func (c *Client) ListClusters(accountID string) ([]Cluster, error) {
 
 	u := c.BaseURL.ResolveReference(rel)
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetQueryParam("account_id", accountID).
 		SetResult(&ClustersResponse{}).
-		SetError(&ErrorResponse{}).
 		Get(u.String())
 
 	if err != nil {
@@ -103,12 +108,57 @@ func (c *Client) ListClusters(accountID string) ([]Cluster, error) {
 	}
 
 	if response.IsError() {
-		return []Cluster{}, fmt.Errorf("clusters: %s", response.Error().(*ErrorResponse).Error.Message)
+		return []Cluster{}, newAPIError("clusters", response)
 	}
 	return response.Result().(*ClustersResponse).Clusters, nil
 }
 
+// ClusterIterator iterates over the clusters of an environment. The v1 API returns the
+// complete list, which is requested by the first call to Next.
+type ClusterIterator struct {
+	pager
+	page    []Cluster
+	current Cluster
+}
+
+// Next advances to the next cluster. It returns false at the end of the
+// list or when the request failed; check Err to tell them apart.
+func (it *ClusterIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Cluster returns the cluster Next advanced to.
+func (it *ClusterIterator) Cluster() Cluster {
+	return it.current
+}
+
+// IterateClusters returns an iterator over the clusters of an environment.
+func (c *Client) IterateClusters(ctx context.Context, accountID string) *ClusterIterator {
+	it := &ClusterIterator{}
+	it.pager = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		var err error
+		it.page, err = c.ListClustersContext(ctx, accountID)
+		return "", err
+	}}
+	return it
+}
+
 func (c *Client) CreateCluster(request ClusterCreateConfig) (*Cluster, error) {
+	return c.CreateClusterContext(context.Background(), request)
+}
+
+func (c *Client) CreateClusterContext(ctx context.Context, request ClusterCreateConfig) (*Cluster, error) {
+	request = request.normalize()
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	rel, err := url.Parse("clusters")
 	if err != nil {
 		return nil, err
@@ -117,9 +167,9 @@ func (c *Client) CreateCluster(request ClusterCreateConfig) (*Cluster, error) {
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(&ClusterCreateRequest{Config: request}).
 		SetResult(&ClusterResponse{}).
-		SetError(&ErrorResponse{}).
 		Post(u.String())
 
 	if err != nil {
@@ -127,13 +177,17 @@ func (c *Client) CreateCluster(request ClusterCreateConfig) (*Cluster, error) {
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("clusters: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("clusters", response)
 	}
 
 	return &response.Result().(*ClusterResponse).Cluster, nil
 }
 
 func (c *Client) DeleteCluster(id, account_id string) error {
+	return c.DeleteClusterContext(context.Background(), id, account_id)
+}
+
+func (c *Client) DeleteClusterContext(ctx context.Context, id, account_id string) error {
 	rel, err := url.Parse(fmt.Sprintf("clusters/%s", id))
 	if err != nil {
 		return err
@@ -142,15 +196,15 @@ func (c *Client) DeleteCluster(id, account_id string) error {
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(
 			map[string]interface{}{
 				"cluster": map[string]interface{}{
-					"id": id,
+					"id":        id,
 					"accountId": account_id,
 				},
 			},
 		).
-		SetError(&ErrorResponse{}).
 		Delete(u.String())
 
 	if err != nil {
@@ -158,14 +212,18 @@ func (c *Client) DeleteCluster(id, account_id string) error {
 	}
 
 	if response.IsError() {
-		return fmt.Errorf("delete cluster: %s", response.Error().(*ErrorResponse).Error.Message)
+		return newAPIError("delete cluster", response)
 	}
 
-	log.Printf("[DEBUG] DeleteCluster Success(%s, %s)", id, account_id)
+	c.logger.Debug("cluster deleted", "id", id, "account_id", account_id)
 	return nil
 }
 
 func (c *Client) GetCluster(id, account_id string) (*Cluster, error) {
+	return c.GetClusterContext(context.Background(), id, account_id)
+}
+
+func (c *Client) GetClusterContext(ctx context.Context, id, account_id string) (*Cluster, error) {
 	rel, err := url.Parse(fmt.Sprintf("clusters/%s", id))
 	if err != nil {
 		return nil, err
@@ -173,12 +231,10 @@ func (c *Client) GetCluster(id, account_id string) (*Cluster, error) {
 
 	u := c.BaseURL.ResolveReference(rel)
 
-	fmt.Println(rel.String())
-
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetResult(&ClusterResponse{}).
 		SetQueryParam("account_id", account_id).
-		SetError(&ErrorResponse{}).
 		Get(u.String())
 
 	if err != nil {
@@ -186,40 +242,62 @@ func (c *Client) GetCluster(id, account_id string) (*Cluster, error) {
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("get cluster: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("get cluster", response)
 	}
 
 	return &response.Result().(*ClusterResponse).Cluster, nil
 }
 
 func (c *Client) UpdateCluster(id, account_id, name string) error {
+	return c.UpdateClusterContext(context.Background(), id, account_id, name)
+}
+
+func (c *Client) UpdateClusterContext(ctx context.Context, id, account_id, name string) error {
+	_, err := c.UpdateClusterConfigContext(ctx, id, account_id, ClusterUpdate{Name: &name})
+	return err
+}
+
+func (c *Client) UpdateClusterConfig(id, account_id string, update ClusterUpdate) (*Cluster, error) {
+	return c.UpdateClusterConfigContext(context.Background(), id, account_id, update)
+}
+
+// UpdateClusterConfigContext applies a partial update to the cluster, after
+// checking that the fields it changes are mutable for the cluster. Changes
+// to the capacity of a cluster take effect asynchronously; use
+// WaitForClusterUpdate to wait for them.
+func (c *Client) UpdateClusterConfigContext(ctx context.Context, id, account_id string, update ClusterUpdate) (*Cluster, error) {
 	rel, err := url.Parse(fmt.Sprintf("clusters/%s", id))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	u := c.BaseURL.ResolveReference(rel)
 
-	data, err := c.GetCluster(id, account_id)
+	data, err := c.GetClusterContext(ctx, id, account_id)
 
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := update.Validate(data); err != nil {
+		return nil, err
 	}
 
-	data.Name = name
+	update.apply(data)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(&ClusterResponse{Cluster: *data}).
-		SetError(&ErrorResponse{}).
+		SetResult(&ClusterResponse{}).
 		Put(u.String())
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if response.IsError() {
-		return fmt.Errorf("update cluster: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("update cluster", response)
 	}
 
-	return nil
+	return &response.Result().(*ClusterResponse).Cluster, nil
 }
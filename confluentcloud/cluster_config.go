@@ -0,0 +1,157 @@
+package confluentcloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ServiceProvider is the cloud a cluster runs in.
+type ServiceProvider string
+
+const (
+	ServiceProviderAWS   ServiceProvider = "aws"
+	ServiceProviderGCP   ServiceProvider = "gcp"
+	ServiceProviderAzure ServiceProvider = "azure"
+)
+
+// normalize returns p in the lower case the API uses, so that e.g. "AWS" is
+// accepted as well.
+func (p ServiceProvider) normalize() ServiceProvider {
+	return ServiceProvider(strings.ToLower(string(p)))
+}
+
+func (p ServiceProvider) valid() bool {
+	switch p {
+	case ServiceProviderAWS, ServiceProviderGCP, ServiceProviderAzure:
+		return true
+	}
+	return false
+}
+
+// ClusterSku is the type of a cluster.
+type ClusterSku string
+
+const (
+	ClusterSkuBasic     ClusterSku = "BASIC"
+	ClusterSkuStandard  ClusterSku = "STANDARD"
+	ClusterSkuDedicated ClusterSku = "DEDICATED"
+)
+
+// normalize returns s in the upper case the API uses.
+func (s ClusterSku) normalize() ClusterSku {
+	return ClusterSku(strings.ToUpper(string(s)))
+}
+
+func (s ClusterSku) valid() bool {
+	switch s {
+	case ClusterSkuBasic, ClusterSkuStandard, ClusterSkuDedicated:
+		return true
+	}
+	return false
+}
+
+// Durability is the availability of a cluster: LOW runs in a single zone,
+// HIGH spans multiple zones.
+type Durability string
+
+const (
+	DurabilityLow  Durability = "LOW"
+	DurabilityHigh Durability = "HIGH"
+)
+
+// normalize returns d in the upper case the API uses.
+func (d Durability) normalize() Durability {
+	return Durability(strings.ToUpper(string(d)))
+}
+
+func (d Durability) valid() bool {
+	return d == DurabilityLow || d == DurabilityHigh
+}
+
+// ValidationError reports a field of a request that the API would reject.
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// Validate checks the config for mistakes the API would reject, so that they
+// surface before CreateCluster sends the request. An empty Durability or
+// Deployment.Sku leaves the choice to the API. ServiceProvider, Durability
+// and Deployment.Sku are compared case-insensitively.
+func (r ClusterCreateConfig) Validate() error {
+	r = r.normalize()
+	required := []struct {
+		field string
+		value string
+	}{
+		{"name", r.Name},
+		{"accountId", r.AccountID},
+		{"region", r.Region},
+		{"serviceProvider", string(r.ServiceProvider)},
+	}
+	for _, f := range required {
+		if f.value == "" {
+			return &ValidationError{Field: f.field, Reason: "must not be empty"}
+		}
+	}
+
+	if !r.ServiceProvider.valid() {
+		return &ValidationError{Field: "serviceProvider", Reason: fmt.Sprintf("unknown service provider %q", r.ServiceProvider)}
+	}
+	if r.Durability != "" && !r.Durability.valid() {
+		return &ValidationError{Field: "durability", Reason: fmt.Sprintf("unknown durability %q", r.Durability)}
+	}
+	sku := r.Deployment.Sku
+	if sku != "" && !sku.valid() {
+		return &ValidationError{Field: "deployment.sku", Reason: fmt.Sprintf("unknown sku %q", sku)}
+	}
+
+	if sku == ClusterSkuBasic && r.Durability == DurabilityHigh {
+		return &ValidationError{Field: "durability", Reason: "basic clusters only support LOW durability"}
+	}
+
+	// Without a sku the API decides whether the CKUs apply.
+	switch {
+	case sku != "" && sku != ClusterSkuDedicated && r.Cku != 0:
+		return &ValidationError{Field: "cku", Reason: "only dedicated clusters have CKUs"}
+	case sku == ClusterSkuDedicated && r.Durability == DurabilityHigh && r.Cku < 2:
+		return &ValidationError{Field: "cku", Reason: "multi-zone dedicated clusters need at least 2 CKUs"}
+	case sku == ClusterSkuDedicated && r.Cku < 1:
+		return &ValidationError{Field: "cku", Reason: "dedicated clusters need at least 1 CKU"}
+	}
+
+	if r.Deployment.NetworkAccess != nil {
+		if err := r.Deployment.NetworkAccess.validate(sku, r.ServiceProvider); err != nil {
+			return err
+		}
+	}
+
+	limits := []struct {
+		field string
+		value int
+	}{
+		{"storage", r.Storage},
+		{"network_ingress", r.NetworkIngress},
+		{"network_egress", r.NetworkEgress},
+	}
+	for _, limit := range limits {
+		if limit.value < 0 {
+			return &ValidationError{Field: limit.field, Reason: "must not be negative"}
+		}
+	}
+
+	return nil
+}
+
+// normalize returns a copy of r with ServiceProvider, Durability and
+// Deployment.Sku in the case the API expects.
+func (r ClusterCreateConfig) normalize() ClusterCreateConfig {
+	r.ServiceProvider = r.ServiceProvider.normalize()
+	r.Durability = r.Durability.normalize()
+	r.Deployment.Sku = r.Deployment.Sku.normalize()
+	return r
+}
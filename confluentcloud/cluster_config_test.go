@@ -0,0 +1,151 @@
+package confluentcloud_test
+
+import (
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+func TestClusterCreateConfigValidate(t *testing.T) {
+	base := confluentcloud.ClusterCreateConfig{
+		Name:            "orders",
+		AccountID:       "env-1",
+		Region:          "us-west-2",
+		ServiceProvider: confluentcloud.ServiceProviderAWS,
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*confluentcloud.ClusterCreateConfig)
+		wantErr string
+	}{
+		{"defaults", func(r *confluentcloud.ClusterCreateConfig) {}, ""},
+		{"upper case provider", func(r *confluentcloud.ClusterCreateConfig) { r.ServiceProvider = "AWS" }, ""},
+		{"mixed case sku and durability", func(r *confluentcloud.ClusterCreateConfig) {
+			r.Deployment.Sku = "Dedicated"
+			r.Durability = "high"
+			r.Cku = 2
+		}, ""},
+		{"unknown provider", func(r *confluentcloud.ClusterCreateConfig) { r.ServiceProvider = "ibm" }, "serviceProvider"},
+		{"missing region", func(r *confluentcloud.ClusterCreateConfig) { r.Region = "" }, "region"},
+		{"unknown sku", func(r *confluentcloud.ClusterCreateConfig) { r.Deployment.Sku = "huge" }, "deployment.sku"},
+		{"basic with high durability", func(r *confluentcloud.ClusterCreateConfig) {
+			r.Deployment.Sku = "basic"
+			r.Durability = "HIGH"
+		}, "durability"},
+		{"cku without sku", func(r *confluentcloud.ClusterCreateConfig) { r.Cku = 1 }, ""},
+		{"cku on standard", func(r *confluentcloud.ClusterCreateConfig) {
+			r.Deployment.Sku = confluentcloud.ClusterSkuStandard
+			r.Cku = 1
+		}, "cku"},
+		{"multi-zone dedicated with one cku", func(r *confluentcloud.ClusterCreateConfig) {
+			r.Deployment.Sku = confluentcloud.ClusterSkuDedicated
+			r.Durability = confluentcloud.DurabilityHigh
+			r.Cku = 1
+		}, "cku"},
+		{"negative storage", func(r *confluentcloud.ClusterCreateConfig) { r.Storage = -1 }, "storage"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := base
+			tt.modify(&r)
+			err := r.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got %v, want no error", err)
+				}
+				return
+			}
+			verr, ok := err.(*confluentcloud.ValidationError)
+			if !ok || verr.Field != tt.wantErr {
+				t.Errorf("got %v, want a validation error for %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClusterNetworkAccessValidate(t *testing.T) {
+	peering := confluentcloud.VpcPeeringAccess{CIDR: "10.0.0.0/16", AccountID: "123456789012", NetworkID: "vpc-1"}
+	gateway := confluentcloud.TransitGatewayAccess{
+		CIDR:             "10.1.0.0/16",
+		TransitGatewayID: "tgw-1",
+		AccountID:        "123456789012",
+		RAMShareARN:      "arn:aws:ram:us-west-2:123456789012:resource-share/1",
+	}
+
+	tests := []struct {
+		name     string
+		sku      confluentcloud.ClusterSku
+		provider confluentcloud.ServiceProvider
+		access   confluentcloud.ClusterDeploymentNetworkAccess
+		wantErr  string
+	}{
+		{"public internet", "dedicated", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			PublicInternet: []confluentcloud.PublicInternetAccess{{CIDRs: []string{"192.0.2.0/24"}}},
+		}, ""},
+		{"vpc peering", "dedicated", "gcp", confluentcloud.ClusterDeploymentNetworkAccess{
+			VpcPeering: []confluentcloud.VpcPeeringAccess{peering},
+		}, ""},
+		{"transit gateway", "dedicated", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			TransitGateway: []confluentcloud.TransitGatewayAccess{gateway},
+		}, ""},
+		{"standard", "standard", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			VpcPeering: []confluentcloud.VpcPeeringAccess{peering},
+		}, "deployment.network_access"},
+		{"no sku", "", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			PublicInternet: []confluentcloud.PublicInternetAccess{{}},
+		}, "deployment.network_access"},
+		{"bad public cidr", "dedicated", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			PublicInternet: []confluentcloud.PublicInternetAccess{{CIDRs: []string{"192.0.2.0"}}},
+		}, "public_internet[0].cidrs"},
+		{"bad peering cidr", "dedicated", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			VpcPeering: []confluentcloud.VpcPeeringAccess{{CIDR: "10.0.0.0/33", AccountID: "1", NetworkID: "vpc-1"}},
+		}, "vpc_peering[0].cidr"},
+		{"bad peering route", "dedicated", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			VpcPeering: []confluentcloud.VpcPeeringAccess{{CIDR: "10.0.0.0/16", AccountID: "1", NetworkID: "vpc-1", Routes: []string{"any"}}},
+		}, "vpc_peering[0].routes"},
+		{"peering without account", "dedicated", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			VpcPeering: []confluentcloud.VpcPeeringAccess{peering, {CIDR: "10.2.0.0/16", NetworkID: "vpc-2"}},
+		}, "vpc_peering[1].account_id"},
+		{"peering without network", "dedicated", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			VpcPeering: []confluentcloud.VpcPeeringAccess{{CIDR: "10.0.0.0/16", AccountID: "1"}},
+		}, "vpc_peering[0].network_id"},
+		{"private link without accounts", "dedicated", "azure", confluentcloud.ClusterDeploymentNetworkAccess{
+			PrivateLink: []confluentcloud.PrivateLinkAccess{{}},
+		}, "private_link[0].allowed_accounts"},
+		{"transit gateway on gcp", "dedicated", "gcp", confluentcloud.ClusterDeploymentNetworkAccess{
+			TransitGateway: []confluentcloud.TransitGatewayAccess{gateway},
+		}, "transit_gateway"},
+		{"transit gateway without ram share", "dedicated", "aws", confluentcloud.ClusterDeploymentNetworkAccess{
+			TransitGateway: []confluentcloud.TransitGatewayAccess{{CIDR: "10.1.0.0/16", TransitGatewayID: "tgw-1"}},
+		}, "transit_gateway[0].ram_share_arn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := tt.access
+			r := confluentcloud.ClusterCreateConfig{
+				Name:            "orders",
+				AccountID:       "env-1",
+				Region:          "us-west-2",
+				ServiceProvider: tt.provider,
+				Deployment:      confluentcloud.ClusterCreateDeploymentConfig{Sku: tt.sku, NetworkAccess: &access},
+			}
+			if tt.sku == "dedicated" {
+				r.Cku = 1
+			}
+			err := r.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got %v, want no error", err)
+				}
+				return
+			}
+			verr, ok := err.(*confluentcloud.ValidationError)
+			if !ok || verr.Field != tt.wantErr {
+				t.Errorf("got %v, want a validation error for %s", err, tt.wantErr)
+			}
+		})
+	}
+}
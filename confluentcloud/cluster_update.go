@@ -0,0 +1,146 @@
+package confluentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ClusterUpdate is a partial update of a cluster for UpdateClusterConfig.
+// Only the fields that are not nil are changed.
+type ClusterUpdate struct {
+	Name *string
+	// Cku resizes a dedicated cluster.
+	Cku *int
+	// Storage, NetworkIngress and NetworkEgress change the limits of
+	// clusters that are not dedicated. The limits of dedicated clusters
+	// follow from their Cku.
+	Storage        *int
+	NetworkIngress *int
+	NetworkEgress  *int
+	// Durability can only be raised from LOW to HIGH, which makes a
+	// cluster span multiple zones. Basic clusters are always LOW.
+	Durability *Durability
+}
+
+// Validate checks that the update only changes fields that are mutable for
+// cluster and that the new values are allowed.
+func (u ClusterUpdate) Validate(cluster *Cluster) error {
+	dedicated := isDedicated(cluster)
+
+	if u.Name != nil && *u.Name == "" {
+		return &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+
+	durability := Durability(cluster.Durability).normalize()
+	if u.Durability != nil && u.Durability.normalize() != durability {
+		want := u.Durability.normalize()
+		switch {
+		case !want.valid():
+			return &ValidationError{Field: "durability", Reason: fmt.Sprintf("unknown durability %q", *u.Durability)}
+		case want == DurabilityLow:
+			return &ValidationError{Field: "durability", Reason: "cannot be lowered from HIGH to LOW"}
+		case strings.EqualFold(cluster.Deployment.Sku, string(ClusterSkuBasic)):
+			return &ValidationError{Field: "durability", Reason: "basic clusters only support LOW durability"}
+		}
+		durability = want
+	}
+
+	if u.Cku != nil {
+		switch {
+		case !dedicated:
+			return &ValidationError{Field: "cku", Reason: "only dedicated clusters can be resized"}
+		case durability == DurabilityHigh && *u.Cku < 2:
+			return &ValidationError{Field: "cku", Reason: "multi-zone clusters need at least 2 CKUs"}
+		case *u.Cku < 1:
+			return &ValidationError{Field: "cku", Reason: "must be at least 1"}
+		}
+	}
+
+	limits := []struct {
+		field string
+		value *int
+		max   int
+	}{
+		{"storage", u.Storage, 0},
+		{"network_ingress", u.NetworkIngress, cluster.MaxNetworkIngress},
+		{"network_egress", u.NetworkEgress, cluster.MaxNetworkEgress},
+	}
+	for _, limit := range limits {
+		switch {
+		case limit.value == nil:
+		case dedicated:
+			return &ValidationError{Field: limit.field, Reason: "is determined by the CKUs of dedicated clusters"}
+		case *limit.value < 1:
+			return &ValidationError{Field: limit.field, Reason: "must be positive"}
+		case limit.max > 0 && *limit.value > limit.max:
+			return &ValidationError{Field: limit.field, Reason: fmt.Sprintf("must not exceed %d", limit.max)}
+		}
+	}
+
+	return nil
+}
+
+func (u ClusterUpdate) apply(cluster *Cluster) {
+	if u.Name != nil {
+		cluster.Name = *u.Name
+	}
+	if u.Cku != nil {
+		cluster.Cku = *u.Cku
+	}
+	if u.Storage != nil {
+		cluster.Storage = *u.Storage
+	}
+	if u.NetworkIngress != nil {
+		cluster.NetworkIngress = *u.NetworkIngress
+	}
+	if u.NetworkEgress != nil {
+		cluster.NetworkEgress = *u.NetworkEgress
+	}
+	if u.Durability != nil {
+		cluster.Durability = string(u.Durability.normalize())
+	}
+}
+
+// applied reports whether cluster reflects all changes of the update.
+func (u ClusterUpdate) applied(cluster *Cluster) bool {
+	want := *cluster
+	u.apply(&want)
+	return want.Name == cluster.Name &&
+		want.Cku == cluster.Cku &&
+		want.Storage == cluster.Storage &&
+		want.NetworkIngress == cluster.NetworkIngress &&
+		want.NetworkEgress == cluster.NetworkEgress &&
+		want.Durability == cluster.Durability
+}
+
+func isDedicated(cluster *Cluster) bool {
+	return cluster.Dedicated || strings.EqualFold(cluster.Deployment.Sku, string(ClusterSkuDedicated))
+}
+
+// WaitForClusterUpdate polls the cluster until it is up and reflects all
+// changes of update, e.g. after a resize with UpdateClusterConfig.
+func (c *Client) WaitForClusterUpdate(id, accountID string, update ClusterUpdate, opts *WaitOptions) (*Cluster, error) {
+	return c.WaitForClusterUpdateContext(context.Background(), id, accountID, update, opts)
+}
+
+// WaitForClusterUpdateContext is like WaitForClusterUpdate, but fails with
+// the error of ctx when ctx is done first.
+func (c *Client) WaitForClusterUpdateContext(ctx context.Context, id, accountID string, update ClusterUpdate, opts *WaitOptions) (*Cluster, error) {
+	var cluster *Cluster
+	err := poll(ctx, opts, func() (bool, error) {
+		var err error
+		cluster, err = c.GetClusterContext(ctx, id, accountID)
+		if err != nil {
+			return false, err
+		}
+		if cluster.Status == ClusterStatusFailed {
+			return false, &ClusterFailedError{ID: id, Status: cluster.Status}
+		}
+		return cluster.Status == ClusterStatusUp && update.applied(cluster), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
@@ -0,0 +1,118 @@
+package confluentcloud_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/confluentcloudtest"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestClusterUpdateValidate(t *testing.T) {
+	standard := &confluentcloud.Cluster{
+		Durability:        "LOW",
+		MaxNetworkIngress: 100,
+		MaxNetworkEgress:  100,
+		Deployment:        confluentcloud.ClusterDeployment{Sku: "STANDARD"},
+	}
+	basic := &confluentcloud.Cluster{
+		Durability: "LOW",
+		Deployment: confluentcloud.ClusterDeployment{Sku: "BASIC"},
+	}
+	dedicated := &confluentcloud.Cluster{
+		Durability: "HIGH",
+		Dedicated:  true,
+		Cku:        2,
+		Deployment: confluentcloud.ClusterDeployment{Sku: "DEDICATED"},
+	}
+	empty := ""
+	high := confluentcloud.DurabilityHigh
+	low := confluentcloud.Durability("low")
+
+	tests := []struct {
+		name    string
+		cluster *confluentcloud.Cluster
+		update  confluentcloud.ClusterUpdate
+		wantErr string
+	}{
+		{"empty update", standard, confluentcloud.ClusterUpdate{}, ""},
+		{"empty update of dedicated cluster", dedicated, confluentcloud.ClusterUpdate{}, ""},
+		{"empty name", standard, confluentcloud.ClusterUpdate{Name: &empty}, "name"},
+		{"resize dedicated", dedicated, confluentcloud.ClusterUpdate{Cku: intPtr(4)}, ""},
+		{"resize standard", standard, confluentcloud.ClusterUpdate{Cku: intPtr(2)}, "cku"},
+		{"resize basic", basic, confluentcloud.ClusterUpdate{Cku: intPtr(1)}, "cku"},
+		{"multi-zone with one cku", dedicated, confluentcloud.ClusterUpdate{Cku: intPtr(1)}, "cku"},
+		{"raise durability", standard, confluentcloud.ClusterUpdate{Durability: &high}, ""},
+		{"lower durability", dedicated, confluentcloud.ClusterUpdate{Durability: &low}, "durability"},
+		{"basic with high durability", basic, confluentcloud.ClusterUpdate{Durability: &high}, "durability"},
+		{"storage of standard", standard, confluentcloud.ClusterUpdate{Storage: intPtr(500)}, ""},
+		{"storage of dedicated", dedicated, confluentcloud.ClusterUpdate{Storage: intPtr(500)}, "storage"},
+		{"zero ingress", standard, confluentcloud.ClusterUpdate{NetworkIngress: intPtr(0)}, "network_ingress"},
+		{"egress above max", standard, confluentcloud.ClusterUpdate{NetworkEgress: intPtr(101)}, "network_egress"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.update.Validate(tt.cluster)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("got %v, want no error", err)
+				}
+				return
+			}
+			verr, ok := err.(*confluentcloud.ValidationError)
+			if !ok || verr.Field != tt.wantErr {
+				t.Errorf("got %v, want a validation error for %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForClusterUpdate(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+
+	cluster, err := c.CreateCluster(confluentcloud.ClusterCreateConfig{
+		Name:            "orders",
+		AccountID:       env,
+		Region:          "us-west-2",
+		ServiceProvider: confluentcloud.ServiceProviderAWS,
+		Durability:      confluentcloud.DurabilityHigh,
+		Deployment:      confluentcloud.ClusterCreateDeploymentConfig{Sku: confluentcloud.ClusterSkuDedicated},
+		Cku:             2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv.ProvisioningPolls = 2
+	update := confluentcloud.ClusterUpdate{Cku: intPtr(4)}
+	resized, err := c.UpdateClusterConfig(cluster.ID, env, update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resized.Status != confluentcloud.ClusterStatusProvisioning {
+		t.Errorf("got status %s while resizing, want %s", resized.Status, confluentcloud.ClusterStatusProvisioning)
+	}
+
+	up, err := c.WaitForClusterUpdate(cluster.ID, env, update, &confluentcloud.WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if up.Status != confluentcloud.ClusterStatusUp || up.Cku != 4 {
+		t.Errorf("got status %s with %d CKUs, want UP with 4", up.Status, up.Cku)
+	}
+
+	// The cluster is up, but never reaches 6 CKUs.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.WaitForClusterUpdateContext(ctx, cluster.ID, env, confluentcloud.ClusterUpdate{Cku: intPtr(6)}, &confluentcloud.WaitOptions{Interval: time.Millisecond})
+	if err == nil || ctx.Err() == nil {
+		t.Errorf("got %v before the deadline for an update that was never applied", err)
+	}
+}
@@ -1,7 +1,11 @@
 package confluentcloud
 
 import (
+	"fmt"
+	"net/http"
 	"net/url"
+	"sync"
+	"time"
 
 	resty "github.com/go-resty/resty/v2"
 )
@@ -12,35 +16,137 @@ const (
 	userAgent      = "go-confluent-cloud " + libraryVersion
 )
 
+// Client talks to the Confluent Cloud API. Every API method has a variant
+// with a Context suffix that takes a context.Context, which cancels the
+// request and bounds it with its deadline.
 type Client struct {
 	BaseURL   *url.URL
 	UserAgent string
-	email     string
-	password  string
-	token     string
-	client    *resty.Client
+	// Authenticator adds credentials to every request sent by the client.
+	// NewClient sets it to an authenticator that sends the session token
+	// obtained by Login.
+	Authenticator Authenticator
+
+	// The resource services of the client. They all refer to the client
+	// itself and can be replaced by fakes in tests.
+	Clusters        ClustersService
+	APIKeys         APIKeysService
+	ServiceAccounts ServiceAccountsService
+	Environments    EnvironmentsService
+	Connectors      ConnectorsService
+	SchemaRegistry  SchemaRegistryService
+	RoleBindings    RoleBindingsService
+
+	email       string
+	password    string
+	mu          sync.RWMutex
+	token       string
+	refreshing  *loginCall
+	client      *resty.Client
+	httpClient  *http.Client
+	timeout     time.Duration
+	debug       bool
+	logger      Logger
+	retryPolicy RetryPolicy
+
+	rateLimiter          *RateLimiter
+	endpointRateLimiters map[string]*RateLimiter
 }
 
 type ErrorMessage struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code         int               `json:"code"`
+	Message      string            `json:"message"`
+	NestedErrors map[string]string `json:"nested_errors,omitempty"`
 }
 
 type ErrorResponse struct {
 	Error ErrorMessage `json:"error"`
 }
 
-func NewClient(email, password string) *Client {
+// NewClient returns a client that authenticates with the session token
+// obtained by logging in with email and password.
+func NewClient(email, password string, opts ...ClientOption) *Client {
+	c := newClient(opts)
+	c.email = email
+	c.password = password
+	c.Authenticator = &SessionAuthenticator{client: c}
+	return c
+}
+
+// NewClientWithAPIKey returns a client that authenticates every request with
+// a Cloud API key and secret, as used by service accounts. Such a client does
+// not need to Login.
+func NewClientWithAPIKey(key, secret string, opts ...ClientOption) *Client {
+	c := newClient(opts)
+	c.Authenticator = &APIKeyAuthenticator{Key: key, Secret: secret}
+	return c
+}
+
+func newClient(opts []ClientOption) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
-	client := resty.New()
-	client.SetDebug(true)
-	c := &Client{BaseURL: baseURL, email: email, password: password, UserAgent: userAgent}
+	c := &Client{BaseURL: baseURL, UserAgent: userAgent, logger: nopLogger{}, retryPolicy: DefaultRetryPolicy}
+	c.Clusters = c
+	c.APIKeys = c
+	c.ServiceAccounts = c
+	c.Environments = c
+	c.Connectors = c
+	c.SchemaRegistry = c
+	c.RoleBindings = c
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	var client *resty.Client
+	if c.httpClient != nil {
+		// Work on a copy, so that wrapping the transport does not affect
+		// the caller's client.
+		hc := *c.httpClient
+		client = resty.NewWithClient(&hc)
+	} else {
+		client = resty.New()
+	}
+	client.SetDebug(c.debug)
+	client.SetLogger(restyLogger{logger: c.logger})
+	client.OnRequestLog(redactRequestLog)
+	client.OnResponseLog(redactResponseLog)
+	c.retryPolicy.apply(client)
+	if c.timeout > 0 {
+		client.SetTimeout(c.timeout)
+	}
+	client.SetTransport(&transport{client: c, base: client.GetClient().Transport})
 	c.client = client
 	return c
 }
 
 func (c *Client) NewRequest() *resty.Request {
 	return c.client.R().
-		SetHeader("User-Agent", c.UserAgent).
-		SetError(&ErrorResponse{})
+		SetHeader("User-Agent", c.UserAgent)
+}
+
+// resolve resolves the path built from format and args against base. The
+// arguments are escaped as path segments.
+func resolve(base *url.URL, format string, args ...string) (string, error) {
+	escaped := make([]interface{}, len(args))
+	for i, arg := range args {
+		escaped[i] = url.PathEscape(arg)
+	}
+
+	rel, err := url.Parse(fmt.Sprintf(format, escaped...))
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(rel).String(), nil
+}
+
+// Token returns the session token obtained by the last successful Login.
+func (c *Client) Token() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token
+}
+
+func (c *Client) setToken(token string) {
+	c.mu.Lock()
+	c.token = token
+	c.mu.Unlock()
 }
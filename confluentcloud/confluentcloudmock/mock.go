@@ -0,0 +1,748 @@
+// Package confluentcloudmock provides mock implementations of the service
+// interfaces of the confluentcloud package.
+//
+// Every mock has one function field per operation. The method without a
+// context calls the field with context.Background(). Calling an operation
+// whose field is nil panics.
+package confluentcloudmock
+
+import (
+	"context"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// ClustersService is a mock confluentcloud.ClustersService.
+type ClustersService struct {
+	ListClustersFunc          func(ctx context.Context, accountID string) ([]confluentcloud.Cluster, error)
+	IterateClustersFunc       func(ctx context.Context, accountID string) *confluentcloud.ClusterIterator
+	CreateClusterFunc         func(ctx context.Context, request confluentcloud.ClusterCreateConfig) (*confluentcloud.Cluster, error)
+	DeleteClusterFunc         func(ctx context.Context, id, accountID string) error
+	GetClusterFunc            func(ctx context.Context, id, accountID string) (*confluentcloud.Cluster, error)
+	UpdateClusterFunc         func(ctx context.Context, id, accountID, name string) error
+	UpdateClusterConfigFunc   func(ctx context.Context, id, accountID string, update confluentcloud.ClusterUpdate) (*confluentcloud.Cluster, error)
+	WaitForClusterFunc        func(ctx context.Context, id, accountID, status string, opts *confluentcloud.WaitOptions) (*confluentcloud.Cluster, error)
+	WaitForClusterDeletedFunc func(ctx context.Context, id, accountID string, opts *confluentcloud.WaitOptions) error
+	WaitForClusterUpdateFunc  func(ctx context.Context, id, accountID string, update confluentcloud.ClusterUpdate, opts *confluentcloud.WaitOptions) (*confluentcloud.Cluster, error)
+}
+
+var _ confluentcloud.ClustersService = (*ClustersService)(nil)
+
+func (m *ClustersService) ListClusters(accountID string) ([]confluentcloud.Cluster, error) {
+	return m.ListClustersContext(context.Background(), accountID)
+}
+
+func (m *ClustersService) ListClustersContext(ctx context.Context, accountID string) ([]confluentcloud.Cluster, error) {
+	return m.ListClustersFunc(ctx, accountID)
+}
+
+func (m *ClustersService) IterateClusters(ctx context.Context, accountID string) *confluentcloud.ClusterIterator {
+	return m.IterateClustersFunc(ctx, accountID)
+}
+
+func (m *ClustersService) CreateCluster(request confluentcloud.ClusterCreateConfig) (*confluentcloud.Cluster, error) {
+	return m.CreateClusterContext(context.Background(), request)
+}
+
+func (m *ClustersService) CreateClusterContext(ctx context.Context, request confluentcloud.ClusterCreateConfig) (*confluentcloud.Cluster, error) {
+	return m.CreateClusterFunc(ctx, request)
+}
+
+func (m *ClustersService) DeleteCluster(id, accountID string) error {
+	return m.DeleteClusterContext(context.Background(), id, accountID)
+}
+
+func (m *ClustersService) DeleteClusterContext(ctx context.Context, id, accountID string) error {
+	return m.DeleteClusterFunc(ctx, id, accountID)
+}
+
+func (m *ClustersService) GetCluster(id, accountID string) (*confluentcloud.Cluster, error) {
+	return m.GetClusterContext(context.Background(), id, accountID)
+}
+
+func (m *ClustersService) GetClusterContext(ctx context.Context, id, accountID string) (*confluentcloud.Cluster, error) {
+	return m.GetClusterFunc(ctx, id, accountID)
+}
+
+func (m *ClustersService) UpdateCluster(id, accountID, name string) error {
+	return m.UpdateClusterContext(context.Background(), id, accountID, name)
+}
+
+func (m *ClustersService) UpdateClusterContext(ctx context.Context, id, accountID, name string) error {
+	return m.UpdateClusterFunc(ctx, id, accountID, name)
+}
+
+func (m *ClustersService) UpdateClusterConfig(id, accountID string, update confluentcloud.ClusterUpdate) (*confluentcloud.Cluster, error) {
+	return m.UpdateClusterConfigContext(context.Background(), id, accountID, update)
+}
+
+func (m *ClustersService) UpdateClusterConfigContext(ctx context.Context, id, accountID string, update confluentcloud.ClusterUpdate) (*confluentcloud.Cluster, error) {
+	return m.UpdateClusterConfigFunc(ctx, id, accountID, update)
+}
+
+func (m *ClustersService) WaitForCluster(id, accountID, status string, opts *confluentcloud.WaitOptions) (*confluentcloud.Cluster, error) {
+	return m.WaitForClusterContext(context.Background(), id, accountID, status, opts)
+}
+
+func (m *ClustersService) WaitForClusterContext(ctx context.Context, id, accountID, status string, opts *confluentcloud.WaitOptions) (*confluentcloud.Cluster, error) {
+	return m.WaitForClusterFunc(ctx, id, accountID, status, opts)
+}
+
+func (m *ClustersService) WaitForClusterDeleted(id, accountID string, opts *confluentcloud.WaitOptions) error {
+	return m.WaitForClusterDeletedContext(context.Background(), id, accountID, opts)
+}
+
+func (m *ClustersService) WaitForClusterDeletedContext(ctx context.Context, id, accountID string, opts *confluentcloud.WaitOptions) error {
+	return m.WaitForClusterDeletedFunc(ctx, id, accountID, opts)
+}
+
+func (m *ClustersService) WaitForClusterUpdate(id, accountID string, update confluentcloud.ClusterUpdate, opts *confluentcloud.WaitOptions) (*confluentcloud.Cluster, error) {
+	return m.WaitForClusterUpdateContext(context.Background(), id, accountID, update, opts)
+}
+
+func (m *ClustersService) WaitForClusterUpdateContext(ctx context.Context, id, accountID string, update confluentcloud.ClusterUpdate, opts *confluentcloud.WaitOptions) (*confluentcloud.Cluster, error) {
+	return m.WaitForClusterUpdateFunc(ctx, id, accountID, update, opts)
+}
+
+// APIKeysService is a mock confluentcloud.APIKeysService.
+type APIKeysService struct {
+	CreateAPIKeyFunc   func(ctx context.Context, request *confluentcloud.ApiKeyCreateRequest) (*confluentcloud.APIKey, error)
+	DeleteAPIKeyFunc   func(ctx context.Context, id, accountID string, logicalClusters []confluentcloud.LogicalCluster) error
+	ListAPIKeysFunc    func(ctx context.Context, clusterID, accountID string) ([]confluentcloud.APIKey, error)
+	IterateAPIKeysFunc func(ctx context.Context, clusterID, accountID string) *confluentcloud.APIKeyIterator
+}
+
+var _ confluentcloud.APIKeysService = (*APIKeysService)(nil)
+
+func (m *APIKeysService) CreateAPIKey(request *confluentcloud.ApiKeyCreateRequest) (*confluentcloud.APIKey, error) {
+	return m.CreateAPIKeyContext(context.Background(), request)
+}
+
+func (m *APIKeysService) CreateAPIKeyContext(ctx context.Context, request *confluentcloud.ApiKeyCreateRequest) (*confluentcloud.APIKey, error) {
+	return m.CreateAPIKeyFunc(ctx, request)
+}
+
+func (m *APIKeysService) DeleteAPIKey(id, accountID string, logicalClusters []confluentcloud.LogicalCluster) error {
+	return m.DeleteAPIKeyContext(context.Background(), id, accountID, logicalClusters)
+}
+
+func (m *APIKeysService) DeleteAPIKeyContext(ctx context.Context, id, accountID string, logicalClusters []confluentcloud.LogicalCluster) error {
+	return m.DeleteAPIKeyFunc(ctx, id, accountID, logicalClusters)
+}
+
+func (m *APIKeysService) ListAPIKeys(clusterID, accountID string) ([]confluentcloud.APIKey, error) {
+	return m.ListAPIKeysContext(context.Background(), clusterID, accountID)
+}
+
+func (m *APIKeysService) ListAPIKeysContext(ctx context.Context, clusterID, accountID string) ([]confluentcloud.APIKey, error) {
+	return m.ListAPIKeysFunc(ctx, clusterID, accountID)
+}
+
+func (m *APIKeysService) IterateAPIKeys(ctx context.Context, clusterID, accountID string) *confluentcloud.APIKeyIterator {
+	return m.IterateAPIKeysFunc(ctx, clusterID, accountID)
+}
+
+// ServiceAccountsService is a mock confluentcloud.ServiceAccountsService.
+type ServiceAccountsService struct {
+	CreateServiceAccountFunc   func(ctx context.Context, request *confluentcloud.ServiceAccountCreateRequest) (*confluentcloud.ServiceAccount, error)
+	ListServiceAccountsFunc    func(ctx context.Context) ([]confluentcloud.ServiceAccount, error)
+	IterateServiceAccountsFunc func(ctx context.Context) *confluentcloud.ServiceAccountIterator
+	DeleteServiceAccountFunc   func(ctx context.Context, id int) error
+}
+
+var _ confluentcloud.ServiceAccountsService = (*ServiceAccountsService)(nil)
+
+func (m *ServiceAccountsService) CreateServiceAccount(request *confluentcloud.ServiceAccountCreateRequest) (*confluentcloud.ServiceAccount, error) {
+	return m.CreateServiceAccountContext(context.Background(), request)
+}
+
+func (m *ServiceAccountsService) CreateServiceAccountContext(ctx context.Context, request *confluentcloud.ServiceAccountCreateRequest) (*confluentcloud.ServiceAccount, error) {
+	return m.CreateServiceAccountFunc(ctx, request)
+}
+
+func (m *ServiceAccountsService) ListServiceAccounts() ([]confluentcloud.ServiceAccount, error) {
+	return m.ListServiceAccountsContext(context.Background())
+}
+
+func (m *ServiceAccountsService) ListServiceAccountsContext(ctx context.Context) ([]confluentcloud.ServiceAccount, error) {
+	return m.ListServiceAccountsFunc(ctx)
+}
+
+func (m *ServiceAccountsService) IterateServiceAccounts(ctx context.Context) *confluentcloud.ServiceAccountIterator {
+	return m.IterateServiceAccountsFunc(ctx)
+}
+
+func (m *ServiceAccountsService) DeleteServiceAccount(id int) error {
+	return m.DeleteServiceAccountContext(context.Background(), id)
+}
+
+func (m *ServiceAccountsService) DeleteServiceAccountContext(ctx context.Context, id int) error {
+	return m.DeleteServiceAccountFunc(ctx, id)
+}
+
+// EnvironmentsService is a mock confluentcloud.EnvironmentsService.
+type EnvironmentsService struct {
+	GetEnvironmentFunc      func(ctx context.Context, id string) (*confluentcloud.Environment, error)
+	ListEnvironmentsFunc    func(ctx context.Context) ([]confluentcloud.Environment, error)
+	IterateEnvironmentsFunc func(ctx context.Context) *confluentcloud.EnvironmentIterator
+	CreateEnvironmentFunc   func(ctx context.Context, name string, organizationID int) (*confluentcloud.Environment, error)
+	DeleteEnvironmentFunc   func(ctx context.Context, id string) error
+	UpdateEnvironmentFunc   func(ctx context.Context, id, newName string, organizationID int) (*confluentcloud.Environment, error)
+}
+
+var _ confluentcloud.EnvironmentsService = (*EnvironmentsService)(nil)
+
+func (m *EnvironmentsService) GetEnvironment(id string) (*confluentcloud.Environment, error) {
+	return m.GetEnvironmentContext(context.Background(), id)
+}
+
+func (m *EnvironmentsService) GetEnvironmentContext(ctx context.Context, id string) (*confluentcloud.Environment, error) {
+	return m.GetEnvironmentFunc(ctx, id)
+}
+
+func (m *EnvironmentsService) ListEnvironments() ([]confluentcloud.Environment, error) {
+	return m.ListEnvironmentsContext(context.Background())
+}
+
+func (m *EnvironmentsService) ListEnvironmentsContext(ctx context.Context) ([]confluentcloud.Environment, error) {
+	return m.ListEnvironmentsFunc(ctx)
+}
+
+func (m *EnvironmentsService) IterateEnvironments(ctx context.Context) *confluentcloud.EnvironmentIterator {
+	return m.IterateEnvironmentsFunc(ctx)
+}
+
+func (m *EnvironmentsService) CreateEnvironment(name string, organizationID int) (*confluentcloud.Environment, error) {
+	return m.CreateEnvironmentContext(context.Background(), name, organizationID)
+}
+
+func (m *EnvironmentsService) CreateEnvironmentContext(ctx context.Context, name string, organizationID int) (*confluentcloud.Environment, error) {
+	return m.CreateEnvironmentFunc(ctx, name, organizationID)
+}
+
+func (m *EnvironmentsService) DeleteEnvironment(id string) error {
+	return m.DeleteEnvironmentContext(context.Background(), id)
+}
+
+func (m *EnvironmentsService) DeleteEnvironmentContext(ctx context.Context, id string) error {
+	return m.DeleteEnvironmentFunc(ctx, id)
+}
+
+func (m *EnvironmentsService) UpdateEnvironment(id, newName string, organizationID int) (*confluentcloud.Environment, error) {
+	return m.UpdateEnvironmentContext(context.Background(), id, newName, organizationID)
+}
+
+func (m *EnvironmentsService) UpdateEnvironmentContext(ctx context.Context, id, newName string, organizationID int) (*confluentcloud.Environment, error) {
+	return m.UpdateEnvironmentFunc(ctx, id, newName, organizationID)
+}
+
+// ConnectorsService is a mock confluentcloud.ConnectorsService.
+type ConnectorsService struct {
+	ListConnectorsFunc          func(ctx context.Context, accountID, clusterID string) ([]confluentcloud.Connector, error)
+	IterateConnectorsFunc       func(ctx context.Context, accountID, clusterID string) *confluentcloud.ConnectorIterator
+	CreateConnectorFunc         func(ctx context.Context, accountID, clusterID, name string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorInfo, error)
+	UpdateConnectorConfigFunc   func(ctx context.Context, accountID, clusterID, name string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorInfo, error)
+	GetConnectorFunc            func(ctx context.Context, accountID, clusterID, name string) (*confluentcloud.ConnectorInfo, error)
+	DeleteConnectorFunc         func(ctx context.Context, accountID, clusterID, name string) error
+	GetConnectorStatusFunc      func(ctx context.Context, accountID, clusterID, name string) (*confluentcloud.ConnectorStatus, error)
+	PauseConnectorFunc          func(ctx context.Context, accountID, clusterID, name string) error
+	ResumeConnectorFunc         func(ctx context.Context, accountID, clusterID, name string) error
+	RestartConnectorFunc        func(ctx context.Context, accountID, clusterID, name string) error
+	RestartTaskFunc             func(ctx context.Context, accountID, clusterID string, task confluentcloud.ConnectorTask) error
+	WaitForConnectorRunningFunc func(ctx context.Context, accountID, clusterID, name string, opts *confluentcloud.WaitOptions) (*confluentcloud.ConnectorStatus, error)
+	ListConnectorPluginsFunc    func(ctx context.Context, accountID, clusterID string) ([]confluentcloud.ConnectorPlugin, error)
+	ValidateConnectorConfigFunc func(ctx context.Context, accountID, clusterID, plugin string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorConfigValidation, error)
+}
+
+var _ confluentcloud.ConnectorsService = (*ConnectorsService)(nil)
+
+func (m *ConnectorsService) ListConnectors(accountID, clusterID string) ([]confluentcloud.Connector, error) {
+	return m.ListConnectorsContext(context.Background(), accountID, clusterID)
+}
+
+func (m *ConnectorsService) ListConnectorsContext(ctx context.Context, accountID, clusterID string) ([]confluentcloud.Connector, error) {
+	return m.ListConnectorsFunc(ctx, accountID, clusterID)
+}
+
+func (m *ConnectorsService) IterateConnectors(ctx context.Context, accountID, clusterID string) *confluentcloud.ConnectorIterator {
+	return m.IterateConnectorsFunc(ctx, accountID, clusterID)
+}
+
+func (m *ConnectorsService) CreateConnector(accountID, clusterID, name string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorInfo, error) {
+	return m.CreateConnectorContext(context.Background(), accountID, clusterID, name, config)
+}
+
+func (m *ConnectorsService) CreateConnectorContext(ctx context.Context, accountID, clusterID, name string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorInfo, error) {
+	return m.CreateConnectorFunc(ctx, accountID, clusterID, name, config)
+}
+
+func (m *ConnectorsService) UpdateConnectorConfig(accountID, clusterID, name string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorInfo, error) {
+	return m.UpdateConnectorConfigContext(context.Background(), accountID, clusterID, name, config)
+}
+
+func (m *ConnectorsService) UpdateConnectorConfigContext(ctx context.Context, accountID, clusterID, name string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorInfo, error) {
+	return m.UpdateConnectorConfigFunc(ctx, accountID, clusterID, name, config)
+}
+
+func (m *ConnectorsService) GetConnector(accountID, clusterID, name string) (*confluentcloud.ConnectorInfo, error) {
+	return m.GetConnectorContext(context.Background(), accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) GetConnectorContext(ctx context.Context, accountID, clusterID, name string) (*confluentcloud.ConnectorInfo, error) {
+	return m.GetConnectorFunc(ctx, accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) DeleteConnector(accountID, clusterID, name string) error {
+	return m.DeleteConnectorContext(context.Background(), accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) DeleteConnectorContext(ctx context.Context, accountID, clusterID, name string) error {
+	return m.DeleteConnectorFunc(ctx, accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) GetConnectorStatus(accountID, clusterID, name string) (*confluentcloud.ConnectorStatus, error) {
+	return m.GetConnectorStatusContext(context.Background(), accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) GetConnectorStatusContext(ctx context.Context, accountID, clusterID, name string) (*confluentcloud.ConnectorStatus, error) {
+	return m.GetConnectorStatusFunc(ctx, accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) PauseConnector(accountID, clusterID, name string) error {
+	return m.PauseConnectorContext(context.Background(), accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) PauseConnectorContext(ctx context.Context, accountID, clusterID, name string) error {
+	return m.PauseConnectorFunc(ctx, accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) ResumeConnector(accountID, clusterID, name string) error {
+	return m.ResumeConnectorContext(context.Background(), accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) ResumeConnectorContext(ctx context.Context, accountID, clusterID, name string) error {
+	return m.ResumeConnectorFunc(ctx, accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) RestartConnector(accountID, clusterID, name string) error {
+	return m.RestartConnectorContext(context.Background(), accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) RestartConnectorContext(ctx context.Context, accountID, clusterID, name string) error {
+	return m.RestartConnectorFunc(ctx, accountID, clusterID, name)
+}
+
+func (m *ConnectorsService) RestartTask(accountID, clusterID string, task confluentcloud.ConnectorTask) error {
+	return m.RestartTaskContext(context.Background(), accountID, clusterID, task)
+}
+
+func (m *ConnectorsService) RestartTaskContext(ctx context.Context, accountID, clusterID string, task confluentcloud.ConnectorTask) error {
+	return m.RestartTaskFunc(ctx, accountID, clusterID, task)
+}
+
+func (m *ConnectorsService) WaitForConnectorRunning(accountID, clusterID, name string, opts *confluentcloud.WaitOptions) (*confluentcloud.ConnectorStatus, error) {
+	return m.WaitForConnectorRunningContext(context.Background(), accountID, clusterID, name, opts)
+}
+
+func (m *ConnectorsService) WaitForConnectorRunningContext(ctx context.Context, accountID, clusterID, name string, opts *confluentcloud.WaitOptions) (*confluentcloud.ConnectorStatus, error) {
+	return m.WaitForConnectorRunningFunc(ctx, accountID, clusterID, name, opts)
+}
+
+func (m *ConnectorsService) ListConnectorPlugins(accountID, clusterID string) ([]confluentcloud.ConnectorPlugin, error) {
+	return m.ListConnectorPluginsContext(context.Background(), accountID, clusterID)
+}
+
+func (m *ConnectorsService) ListConnectorPluginsContext(ctx context.Context, accountID, clusterID string) ([]confluentcloud.ConnectorPlugin, error) {
+	return m.ListConnectorPluginsFunc(ctx, accountID, clusterID)
+}
+
+func (m *ConnectorsService) ValidateConnectorConfig(accountID, clusterID, plugin string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorConfigValidation, error) {
+	return m.ValidateConnectorConfigContext(context.Background(), accountID, clusterID, plugin, config)
+}
+
+func (m *ConnectorsService) ValidateConnectorConfigContext(ctx context.Context, accountID, clusterID, plugin string, config confluentcloud.ConnectorConfig) (*confluentcloud.ConnectorConfigValidation, error) {
+	return m.ValidateConnectorConfigFunc(ctx, accountID, clusterID, plugin, config)
+}
+
+// SchemaRegistryService is a mock confluentcloud.SchemaRegistryService.
+type SchemaRegistryService struct {
+	GetSchemaRegistryFunc              func(ctx context.Context, accountID string) (*confluentcloud.SchemaRegistry, error)
+	ListSchemaRegistriesFunc           func(ctx context.Context, accountID string) ([]confluentcloud.SchemaRegistry, error)
+	GetSchemaRegistryByIDFunc          func(ctx context.Context, id, accountID string) (*confluentcloud.SchemaRegistry, error)
+	CreateSchemaRegistryFunc           func(ctx context.Context, accountID, location, serviceProvider string) (*confluentcloud.SchemaRegistry, error)
+	CreateSchemaRegistryWithConfigFunc func(ctx context.Context, config confluentcloud.SchemaRegistryCreateConfig) (*confluentcloud.SchemaRegistry, error)
+	DeleteSchemaRegistryFunc           func(ctx context.Context, id, accountID string) error
+}
+
+var _ confluentcloud.SchemaRegistryService = (*SchemaRegistryService)(nil)
+
+func (m *SchemaRegistryService) GetSchemaRegistry(accountID string) (*confluentcloud.SchemaRegistry, error) {
+	return m.GetSchemaRegistryContext(context.Background(), accountID)
+}
+
+func (m *SchemaRegistryService) GetSchemaRegistryContext(ctx context.Context, accountID string) (*confluentcloud.SchemaRegistry, error) {
+	return m.GetSchemaRegistryFunc(ctx, accountID)
+}
+
+func (m *SchemaRegistryService) ListSchemaRegistries(accountID string) ([]confluentcloud.SchemaRegistry, error) {
+	return m.ListSchemaRegistriesContext(context.Background(), accountID)
+}
+
+func (m *SchemaRegistryService) ListSchemaRegistriesContext(ctx context.Context, accountID string) ([]confluentcloud.SchemaRegistry, error) {
+	return m.ListSchemaRegistriesFunc(ctx, accountID)
+}
+
+func (m *SchemaRegistryService) GetSchemaRegistryByID(id, accountID string) (*confluentcloud.SchemaRegistry, error) {
+	return m.GetSchemaRegistryByIDContext(context.Background(), id, accountID)
+}
+
+func (m *SchemaRegistryService) GetSchemaRegistryByIDContext(ctx context.Context, id, accountID string) (*confluentcloud.SchemaRegistry, error) {
+	return m.GetSchemaRegistryByIDFunc(ctx, id, accountID)
+}
+
+func (m *SchemaRegistryService) CreateSchemaRegistry(accountID, location, serviceProvider string) (*confluentcloud.SchemaRegistry, error) {
+	return m.CreateSchemaRegistryContext(context.Background(), accountID, location, serviceProvider)
+}
+
+func (m *SchemaRegistryService) CreateSchemaRegistryContext(ctx context.Context, accountID, location, serviceProvider string) (*confluentcloud.SchemaRegistry, error) {
+	return m.CreateSchemaRegistryFunc(ctx, accountID, location, serviceProvider)
+}
+
+func (m *SchemaRegistryService) CreateSchemaRegistryWithConfig(config confluentcloud.SchemaRegistryCreateConfig) (*confluentcloud.SchemaRegistry, error) {
+	return m.CreateSchemaRegistryWithConfigContext(context.Background(), config)
+}
+
+func (m *SchemaRegistryService) CreateSchemaRegistryWithConfigContext(ctx context.Context, config confluentcloud.SchemaRegistryCreateConfig) (*confluentcloud.SchemaRegistry, error) {
+	return m.CreateSchemaRegistryWithConfigFunc(ctx, config)
+}
+
+func (m *SchemaRegistryService) DeleteSchemaRegistry(id, accountID string) error {
+	return m.DeleteSchemaRegistryContext(context.Background(), id, accountID)
+}
+
+func (m *SchemaRegistryService) DeleteSchemaRegistryContext(ctx context.Context, id, accountID string) error {
+	return m.DeleteSchemaRegistryFunc(ctx, id, accountID)
+}
+
+// RoleBindingsService is a mock confluentcloud.RoleBindingsService.
+type RoleBindingsService struct {
+	ListRolesFunc             func(ctx context.Context) ([]confluentcloud.Role, error)
+	ListRoleBindingsFunc      func(ctx context.Context, filter confluentcloud.RoleBindingFilter) ([]confluentcloud.RoleBinding, error)
+	IterateRoleBindingsFunc   func(ctx context.Context, filter confluentcloud.RoleBindingFilter, opts *confluentcloud.ListOptions) *confluentcloud.RoleBindingIterator
+	CreateRoleBindingFunc     func(ctx context.Context, principal, roleName string, scope confluentcloud.RoleBindingScope) (*confluentcloud.RoleBinding, error)
+	DeleteRoleBindingFunc     func(ctx context.Context, id string) error
+	EffectiveRoleBindingsFunc func(ctx context.Context, principal string, scope confluentcloud.RoleBindingScope) ([]confluentcloud.RoleBinding, error)
+}
+
+var _ confluentcloud.RoleBindingsService = (*RoleBindingsService)(nil)
+
+func (m *RoleBindingsService) ListRoles() ([]confluentcloud.Role, error) {
+	return m.ListRolesContext(context.Background())
+}
+
+func (m *RoleBindingsService) ListRolesContext(ctx context.Context) ([]confluentcloud.Role, error) {
+	return m.ListRolesFunc(ctx)
+}
+
+func (m *RoleBindingsService) ListRoleBindings(filter confluentcloud.RoleBindingFilter) ([]confluentcloud.RoleBinding, error) {
+	return m.ListRoleBindingsContext(context.Background(), filter)
+}
+
+func (m *RoleBindingsService) ListRoleBindingsContext(ctx context.Context, filter confluentcloud.RoleBindingFilter) ([]confluentcloud.RoleBinding, error) {
+	return m.ListRoleBindingsFunc(ctx, filter)
+}
+
+func (m *RoleBindingsService) IterateRoleBindings(ctx context.Context, filter confluentcloud.RoleBindingFilter, opts *confluentcloud.ListOptions) *confluentcloud.RoleBindingIterator {
+	return m.IterateRoleBindingsFunc(ctx, filter, opts)
+}
+
+func (m *RoleBindingsService) CreateRoleBinding(principal, roleName string, scope confluentcloud.RoleBindingScope) (*confluentcloud.RoleBinding, error) {
+	return m.CreateRoleBindingContext(context.Background(), principal, roleName, scope)
+}
+
+func (m *RoleBindingsService) CreateRoleBindingContext(ctx context.Context, principal, roleName string, scope confluentcloud.RoleBindingScope) (*confluentcloud.RoleBinding, error) {
+	return m.CreateRoleBindingFunc(ctx, principal, roleName, scope)
+}
+
+func (m *RoleBindingsService) DeleteRoleBinding(id string) error {
+	return m.DeleteRoleBindingContext(context.Background(), id)
+}
+
+func (m *RoleBindingsService) DeleteRoleBindingContext(ctx context.Context, id string) error {
+	return m.DeleteRoleBindingFunc(ctx, id)
+}
+
+func (m *RoleBindingsService) EffectiveRoleBindings(principal string, scope confluentcloud.RoleBindingScope) ([]confluentcloud.RoleBinding, error) {
+	return m.EffectiveRoleBindingsContext(context.Background(), principal, scope)
+}
+
+func (m *RoleBindingsService) EffectiveRoleBindingsContext(ctx context.Context, principal string, scope confluentcloud.RoleBindingScope) ([]confluentcloud.RoleBinding, error) {
+	return m.EffectiveRoleBindingsFunc(ctx, principal, scope)
+}
+
+// SchemasService is a mock confluentcloud.SchemasService.
+type SchemasService struct {
+	ListSubjectsFunc        func(ctx context.Context, deleted bool) ([]string, error)
+	ListVersionsFunc        func(ctx context.Context, subject string, deleted bool) ([]int, error)
+	GetSchemaFunc           func(ctx context.Context, subject string, version int) (*confluentcloud.Schema, error)
+	GetSchemaByIDFunc       func(ctx context.Context, id int) (*confluentcloud.Schema, error)
+	RegisterSchemaFunc      func(ctx context.Context, subject string, schema *confluentcloud.Schema) (int, error)
+	DeleteSchemaVersionFunc func(ctx context.Context, subject string, version int, permanent bool) error
+	DeleteSubjectFunc       func(ctx context.Context, subject string, permanent bool) ([]int, error)
+	TestCompatibilityFunc   func(ctx context.Context, subject string, version int, schema *confluentcloud.Schema) (*confluentcloud.CompatibilityResult, error)
+}
+
+var _ confluentcloud.SchemasService = (*SchemasService)(nil)
+
+func (m *SchemasService) ListSubjects(deleted bool) ([]string, error) {
+	return m.ListSubjectsContext(context.Background(), deleted)
+}
+
+func (m *SchemasService) ListSubjectsContext(ctx context.Context, deleted bool) ([]string, error) {
+	return m.ListSubjectsFunc(ctx, deleted)
+}
+
+func (m *SchemasService) ListVersions(subject string, deleted bool) ([]int, error) {
+	return m.ListVersionsContext(context.Background(), subject, deleted)
+}
+
+func (m *SchemasService) ListVersionsContext(ctx context.Context, subject string, deleted bool) ([]int, error) {
+	return m.ListVersionsFunc(ctx, subject, deleted)
+}
+
+func (m *SchemasService) GetSchema(subject string, version int) (*confluentcloud.Schema, error) {
+	return m.GetSchemaContext(context.Background(), subject, version)
+}
+
+func (m *SchemasService) GetSchemaContext(ctx context.Context, subject string, version int) (*confluentcloud.Schema, error) {
+	return m.GetSchemaFunc(ctx, subject, version)
+}
+
+func (m *SchemasService) GetSchemaByID(id int) (*confluentcloud.Schema, error) {
+	return m.GetSchemaByIDContext(context.Background(), id)
+}
+
+func (m *SchemasService) GetSchemaByIDContext(ctx context.Context, id int) (*confluentcloud.Schema, error) {
+	return m.GetSchemaByIDFunc(ctx, id)
+}
+
+func (m *SchemasService) RegisterSchema(subject string, schema *confluentcloud.Schema) (int, error) {
+	return m.RegisterSchemaContext(context.Background(), subject, schema)
+}
+
+func (m *SchemasService) RegisterSchemaContext(ctx context.Context, subject string, schema *confluentcloud.Schema) (int, error) {
+	return m.RegisterSchemaFunc(ctx, subject, schema)
+}
+
+func (m *SchemasService) DeleteSchemaVersion(subject string, version int, permanent bool) error {
+	return m.DeleteSchemaVersionContext(context.Background(), subject, version, permanent)
+}
+
+func (m *SchemasService) DeleteSchemaVersionContext(ctx context.Context, subject string, version int, permanent bool) error {
+	return m.DeleteSchemaVersionFunc(ctx, subject, version, permanent)
+}
+
+func (m *SchemasService) DeleteSubject(subject string, permanent bool) ([]int, error) {
+	return m.DeleteSubjectContext(context.Background(), subject, permanent)
+}
+
+func (m *SchemasService) DeleteSubjectContext(ctx context.Context, subject string, permanent bool) ([]int, error) {
+	return m.DeleteSubjectFunc(ctx, subject, permanent)
+}
+
+func (m *SchemasService) TestCompatibility(subject string, version int, schema *confluentcloud.Schema) (*confluentcloud.CompatibilityResult, error) {
+	return m.TestCompatibilityContext(context.Background(), subject, version, schema)
+}
+
+func (m *SchemasService) TestCompatibilityContext(ctx context.Context, subject string, version int, schema *confluentcloud.Schema) (*confluentcloud.CompatibilityResult, error) {
+	return m.TestCompatibilityFunc(ctx, subject, version, schema)
+}
+
+// SchemaConfigService is a mock confluentcloud.SchemaConfigService.
+type SchemaConfigService struct {
+	GetCompatibilityFunc           func(ctx context.Context) (confluentcloud.CompatibilityLevel, error)
+	SetCompatibilityFunc           func(ctx context.Context, level confluentcloud.CompatibilityLevel) error
+	GetSubjectCompatibilityFunc    func(ctx context.Context, subject string) (confluentcloud.CompatibilityLevel, error)
+	SetSubjectCompatibilityFunc    func(ctx context.Context, subject string, level confluentcloud.CompatibilityLevel) error
+	DeleteSubjectCompatibilityFunc func(ctx context.Context, subject string) error
+	GetModeFunc                    func(ctx context.Context) (confluentcloud.SchemaRegistryMode, error)
+	SetModeFunc                    func(ctx context.Context, mode confluentcloud.SchemaRegistryMode) error
+	GetSubjectModeFunc             func(ctx context.Context, subject string) (confluentcloud.SchemaRegistryMode, error)
+	SetSubjectModeFunc             func(ctx context.Context, subject string, mode confluentcloud.SchemaRegistryMode) error
+	DeleteSubjectModeFunc          func(ctx context.Context, subject string) error
+}
+
+var _ confluentcloud.SchemaConfigService = (*SchemaConfigService)(nil)
+
+func (m *SchemaConfigService) GetCompatibility() (confluentcloud.CompatibilityLevel, error) {
+	return m.GetCompatibilityContext(context.Background())
+}
+
+func (m *SchemaConfigService) GetCompatibilityContext(ctx context.Context) (confluentcloud.CompatibilityLevel, error) {
+	return m.GetCompatibilityFunc(ctx)
+}
+
+func (m *SchemaConfigService) SetCompatibility(level confluentcloud.CompatibilityLevel) error {
+	return m.SetCompatibilityContext(context.Background(), level)
+}
+
+func (m *SchemaConfigService) SetCompatibilityContext(ctx context.Context, level confluentcloud.CompatibilityLevel) error {
+	return m.SetCompatibilityFunc(ctx, level)
+}
+
+func (m *SchemaConfigService) GetSubjectCompatibility(subject string) (confluentcloud.CompatibilityLevel, error) {
+	return m.GetSubjectCompatibilityContext(context.Background(), subject)
+}
+
+func (m *SchemaConfigService) GetSubjectCompatibilityContext(ctx context.Context, subject string) (confluentcloud.CompatibilityLevel, error) {
+	return m.GetSubjectCompatibilityFunc(ctx, subject)
+}
+
+func (m *SchemaConfigService) SetSubjectCompatibility(subject string, level confluentcloud.CompatibilityLevel) error {
+	return m.SetSubjectCompatibilityContext(context.Background(), subject, level)
+}
+
+func (m *SchemaConfigService) SetSubjectCompatibilityContext(ctx context.Context, subject string, level confluentcloud.CompatibilityLevel) error {
+	return m.SetSubjectCompatibilityFunc(ctx, subject, level)
+}
+
+func (m *SchemaConfigService) DeleteSubjectCompatibility(subject string) error {
+	return m.DeleteSubjectCompatibilityContext(context.Background(), subject)
+}
+
+func (m *SchemaConfigService) DeleteSubjectCompatibilityContext(ctx context.Context, subject string) error {
+	return m.DeleteSubjectCompatibilityFunc(ctx, subject)
+}
+
+func (m *SchemaConfigService) GetMode() (confluentcloud.SchemaRegistryMode, error) {
+	return m.GetModeContext(context.Background())
+}
+
+func (m *SchemaConfigService) GetModeContext(ctx context.Context) (confluentcloud.SchemaRegistryMode, error) {
+	return m.GetModeFunc(ctx)
+}
+
+func (m *SchemaConfigService) SetMode(mode confluentcloud.SchemaRegistryMode) error {
+	return m.SetModeContext(context.Background(), mode)
+}
+
+func (m *SchemaConfigService) SetModeContext(ctx context.Context, mode confluentcloud.SchemaRegistryMode) error {
+	return m.SetModeFunc(ctx, mode)
+}
+
+func (m *SchemaConfigService) GetSubjectMode(subject string) (confluentcloud.SchemaRegistryMode, error) {
+	return m.GetSubjectModeContext(context.Background(), subject)
+}
+
+func (m *SchemaConfigService) GetSubjectModeContext(ctx context.Context, subject string) (confluentcloud.SchemaRegistryMode, error) {
+	return m.GetSubjectModeFunc(ctx, subject)
+}
+
+func (m *SchemaConfigService) SetSubjectMode(subject string, mode confluentcloud.SchemaRegistryMode) error {
+	return m.SetSubjectModeContext(context.Background(), subject, mode)
+}
+
+func (m *SchemaConfigService) SetSubjectModeContext(ctx context.Context, subject string, mode confluentcloud.SchemaRegistryMode) error {
+	return m.SetSubjectModeFunc(ctx, subject, mode)
+}
+
+func (m *SchemaConfigService) DeleteSubjectMode(subject string) error {
+	return m.DeleteSubjectModeContext(context.Background(), subject)
+}
+
+func (m *SchemaConfigService) DeleteSubjectModeContext(ctx context.Context, subject string) error {
+	return m.DeleteSubjectModeFunc(ctx, subject)
+}
+
+// TopicsService is a mock confluentcloud.TopicsService.
+type TopicsService struct {
+	ListTopicsFunc        func(ctx context.Context) ([]confluentcloud.Topic, error)
+	GetTopicFunc          func(ctx context.Context, name string) (*confluentcloud.Topic, error)
+	CreateTopicFunc       func(ctx context.Context, request *confluentcloud.TopicCreateRequest) (*confluentcloud.Topic, error)
+	DeleteTopicFunc       func(ctx context.Context, name string) error
+	GetTopicConfigsFunc   func(ctx context.Context, name string) ([]confluentcloud.TopicConfig, error)
+	AlterTopicConfigsFunc func(ctx context.Context, name string, changes []confluentcloud.TopicConfigAlter) error
+}
+
+var _ confluentcloud.TopicsService = (*TopicsService)(nil)
+
+func (m *TopicsService) ListTopics() ([]confluentcloud.Topic, error) {
+	return m.ListTopicsContext(context.Background())
+}
+
+func (m *TopicsService) ListTopicsContext(ctx context.Context) ([]confluentcloud.Topic, error) {
+	return m.ListTopicsFunc(ctx)
+}
+
+func (m *TopicsService) GetTopic(name string) (*confluentcloud.Topic, error) {
+	return m.GetTopicContext(context.Background(), name)
+}
+
+func (m *TopicsService) GetTopicContext(ctx context.Context, name string) (*confluentcloud.Topic, error) {
+	return m.GetTopicFunc(ctx, name)
+}
+
+func (m *TopicsService) CreateTopic(request *confluentcloud.TopicCreateRequest) (*confluentcloud.Topic, error) {
+	return m.CreateTopicContext(context.Background(), request)
+}
+
+func (m *TopicsService) CreateTopicContext(ctx context.Context, request *confluentcloud.TopicCreateRequest) (*confluentcloud.Topic, error) {
+	return m.CreateTopicFunc(ctx, request)
+}
+
+func (m *TopicsService) DeleteTopic(name string) error {
+	return m.DeleteTopicContext(context.Background(), name)
+}
+
+func (m *TopicsService) DeleteTopicContext(ctx context.Context, name string) error {
+	return m.DeleteTopicFunc(ctx, name)
+}
+
+func (m *TopicsService) GetTopicConfigs(name string) ([]confluentcloud.TopicConfig, error) {
+	return m.GetTopicConfigsContext(context.Background(), name)
+}
+
+func (m *TopicsService) GetTopicConfigsContext(ctx context.Context, name string) ([]confluentcloud.TopicConfig, error) {
+	return m.GetTopicConfigsFunc(ctx, name)
+}
+
+func (m *TopicsService) AlterTopicConfigs(name string, changes []confluentcloud.TopicConfigAlter) error {
+	return m.AlterTopicConfigsContext(context.Background(), name, changes)
+}
+
+func (m *TopicsService) AlterTopicConfigsContext(ctx context.Context, name string, changes []confluentcloud.TopicConfigAlter) error {
+	return m.AlterTopicConfigsFunc(ctx, name, changes)
+}
+
+// ACLsService is a mock confluentcloud.ACLsService.
+type ACLsService struct {
+	CreateACLFunc  func(ctx context.Context, binding confluentcloud.ACLBinding) error
+	ListACLsFunc   func(ctx context.Context, filter confluentcloud.ACLFilter) ([]confluentcloud.ACLBinding, error)
+	DeleteACLsFunc func(ctx context.Context, filter confluentcloud.ACLFilter) ([]confluentcloud.ACLBinding, error)
+}
+
+var _ confluentcloud.ACLsService = (*ACLsService)(nil)
+
+func (m *ACLsService) CreateACL(binding confluentcloud.ACLBinding) error {
+	return m.CreateACLContext(context.Background(), binding)
+}
+
+func (m *ACLsService) CreateACLContext(ctx context.Context, binding confluentcloud.ACLBinding) error {
+	return m.CreateACLFunc(ctx, binding)
+}
+
+func (m *ACLsService) ListACLs(filter confluentcloud.ACLFilter) ([]confluentcloud.ACLBinding, error) {
+	return m.ListACLsContext(context.Background(), filter)
+}
+
+func (m *ACLsService) ListACLsContext(ctx context.Context, filter confluentcloud.ACLFilter) ([]confluentcloud.ACLBinding, error) {
+	return m.ListACLsFunc(ctx, filter)
+}
+
+func (m *ACLsService) DeleteACLs(filter confluentcloud.ACLFilter) ([]confluentcloud.ACLBinding, error) {
+	return m.DeleteACLsContext(context.Background(), filter)
+}
+
+func (m *ACLsService) DeleteACLsContext(ctx context.Context, filter confluentcloud.ACLFilter) ([]confluentcloud.ACLBinding, error) {
+	return m.DeleteACLsFunc(ctx, filter)
+}
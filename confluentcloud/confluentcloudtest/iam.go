@@ -0,0 +1,136 @@
+package confluentcloudtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// roles are the predefined roles of the fake.
+var roles = []confluentcloud.Role{
+	{Name: "OrganizationAdmin", Policies: []confluentcloud.RolePolicy{{BindingScope: "organization"}}},
+	{Name: "EnvironmentAdmin", Policies: []confluentcloud.RolePolicy{{BindingScope: "environment"}}},
+	{Name: "CloudClusterAdmin", Policies: []confluentcloud.RolePolicy{{BindingScope: "cluster"}}},
+	{Name: "DeveloperRead", Policies: []confluentcloud.RolePolicy{{BindingScope: "resource"}}},
+	{Name: "DeveloperWrite", Policies: []confluentcloud.RolePolicy{{BindingScope: "resource"}}},
+	{Name: "ResourceOwner", Policies: []confluentcloud.RolePolicy{{BindingScope: "resource"}}},
+}
+
+func (s *Server) serveIAM(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 1 && path[0] == "roles":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, confluentcloud.RolesResponse{Data: roles})
+	case len(path) >= 1 && path[0] == "role-bindings":
+		s.serveRoleBindings(w, r, path[1:])
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) serveRoleBindings(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listRoleBindings(w, r)
+		case http.MethodPost:
+			s.createRoleBinding(w, r)
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	if _, ok := s.roleBindings[path[0]]; !ok || len(path) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("role binding %s not found", path[0]))
+		return
+	}
+	if r.Method != http.MethodDelete {
+		methodNotAllowed(w, r)
+		return
+	}
+	delete(s.roleBindings, path[0])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listRoleBindings(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	is := func(param, value string) bool {
+		want := query.Get(param)
+		return want == "" || want == value
+	}
+
+	list := make([]confluentcloud.RoleBinding, 0)
+	for _, rb := range s.roleBindings {
+		if is("principal", rb.Principal) && is("role_name", rb.RoleName) && is("crn_pattern", rb.CRNPattern) {
+			list = append(list, *rb)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+
+	start, end, next, ok := page(w, r, len(list))
+	if !ok {
+		return
+	}
+	response := confluentcloud.RoleBindingsResponse{Data: list[start:end]}
+	if next != "" {
+		u := *s.baseURL().ResolveReference(r.URL)
+		query.Set("page_token", next)
+		u.RawQuery = query.Encode()
+		response.Metadata.Next = u.String()
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) createRoleBinding(w http.ResponseWriter, r *http.Request) {
+	var req confluentcloud.RoleBinding
+	if !decode(w, r, &req) {
+		return
+	}
+
+	invalid := make(map[string]string)
+	if req.Principal == "" {
+		invalid["principal"] = "must not be empty"
+	}
+	if !hasRole(req.RoleName) {
+		invalid["role_name"] = fmt.Sprintf("unknown role %q", req.RoleName)
+	}
+	if !strings.HasPrefix(req.CRNPattern, "crn://confluent.cloud/organization=") {
+		invalid["crn_pattern"] = "must be a CRN within an organization"
+	}
+	if len(invalid) > 0 {
+		writeValidationError(w, invalid)
+		return
+	}
+
+	for _, rb := range s.roleBindings {
+		if rb.Principal == req.Principal && rb.RoleName == req.RoleName && rb.CRNPattern == req.CRNPattern {
+			writeError(w, http.StatusConflict, "role binding already exists")
+			return
+		}
+	}
+
+	rb := &confluentcloud.RoleBinding{
+		ID:         s.newID("rb"),
+		Principal:  req.Principal,
+		RoleName:   req.RoleName,
+		CRNPattern: req.CRNPattern,
+	}
+	s.roleBindings[rb.ID] = rb
+	writeJSON(w, http.StatusCreated, rb)
+}
+
+func hasRole(name string) bool {
+	for _, role := range roles {
+		if role.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+package confluentcloudtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// topicDefaults are the configs every topic starts with and the only ones
+// that can be altered.
+var topicDefaults = map[string]string{
+	"cleanup.policy":      "delete",
+	"max.message.bytes":   "2097164",
+	"min.insync.replicas": "2",
+	"retention.bytes":     "-1",
+	"retention.ms":        "604800000",
+}
+
+type topic struct {
+	confluentcloud.Topic
+	configs map[string]string
+}
+
+// serveKafka serves the Kafka REST API of a cluster. Requests must be
+// authenticated with an API key of the cluster.
+func (s *Server) serveKafka(w http.ResponseWriter, r *http.Request, clusterID string, path []string) {
+	c, ok := s.clusters[clusterID]
+	if !ok || c.Status != "UP" {
+		writeKafkaError(w, http.StatusNotFound, 40403, fmt.Sprintf("cluster %s not found", clusterID))
+		return
+	}
+	if !s.authenticatedForCluster(r, clusterID) {
+		writeKafkaError(w, http.StatusUnauthorized, 40101, "Unauthorized")
+		return
+	}
+	if c.topics == nil {
+		c.topics = make(map[string]*topic)
+	}
+
+	switch {
+	case len(path) >= 1 && path[0] == "topics":
+		s.serveTopics(w, r, c, path[1:])
+	case len(path) == 1 && path[0] == "acls":
+		s.serveACLs(w, r, c)
+	default:
+		writeKafkaError(w, http.StatusNotFound, 404, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) authenticatedForCluster(r *http.Request, clusterID string) bool {
+	key, secret, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	for _, k := range s.apiKeys {
+		if k.Key == key && k.Secret == secret && hasLogicalCluster(k, clusterID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) serveTopics(w http.ResponseWriter, r *http.Request, c *cluster, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]confluentcloud.Topic, 0, len(c.topics))
+			for _, t := range c.topics {
+				list = append(list, t.Topic)
+			}
+			sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+			writeJSON(w, http.StatusOK, confluentcloud.TopicsResponse{Data: list})
+		case http.MethodPost:
+			s.createTopic(w, r, c)
+		default:
+			writeKafkaError(w, http.StatusMethodNotAllowed, 405, fmt.Sprintf("method %s not allowed on %s", r.Method, r.URL.Path))
+		}
+		return
+	}
+
+	t, ok := c.topics[path[0]]
+	if !ok || len(path) > 2 {
+		writeKafkaError(w, http.StatusNotFound, 40403, "This server does not host this topic-partition.")
+		return
+	}
+
+	switch {
+	case len(path) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, t.Topic)
+	case len(path) == 1 && r.Method == http.MethodDelete:
+		delete(c.topics, t.Name)
+		w.WriteHeader(http.StatusNoContent)
+	case path[1] == "configs" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, confluentcloud.TopicConfigsResponse{Data: t.configList()})
+	case path[1] == "configs:alter" && r.Method == http.MethodPost:
+		var req confluentcloud.TopicConfigsAlterRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		for _, change := range req.Data {
+			if _, ok := topicDefaults[change.Name]; !ok {
+				writeKafkaError(w, http.StatusBadRequest, 40002, fmt.Sprintf("Unknown topic config name: %s", change.Name))
+				return
+			}
+		}
+		for _, change := range req.Data {
+			if change.Operation == confluentcloud.ConfigOperationDelete {
+				delete(t.configs, change.Name)
+			} else {
+				t.configs[change.Name] = change.Value
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeKafkaError(w, http.StatusMethodNotAllowed, 405, fmt.Sprintf("method %s not allowed on %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) createTopic(w http.ResponseWriter, r *http.Request, c *cluster) {
+	var req confluentcloud.TopicCreateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeKafkaError(w, http.StatusBadRequest, 40002, "Topic name is required.")
+		return
+	}
+	if _, ok := c.topics[req.Name]; ok {
+		writeKafkaError(w, http.StatusBadRequest, 40002, fmt.Sprintf("Topic '%s' already exists.", req.Name))
+		return
+	}
+
+	t := &topic{
+		Topic: confluentcloud.Topic{
+			Name:              req.Name,
+			ClusterID:         c.ID,
+			ReplicationFactor: req.ReplicationFactor,
+			PartitionsCount:   req.PartitionsCount,
+		},
+		configs: make(map[string]string),
+	}
+	if t.ReplicationFactor == 0 {
+		t.ReplicationFactor = 3
+	}
+	if t.PartitionsCount == 0 {
+		t.PartitionsCount = 6
+	}
+	for _, config := range req.Configs {
+		if _, ok := topicDefaults[config.Name]; !ok {
+			writeKafkaError(w, http.StatusBadRequest, 40002, fmt.Sprintf("Unknown topic config name: %s", config.Name))
+			return
+		}
+		t.configs[config.Name] = config.Value
+	}
+	c.topics[t.Name] = t
+	writeJSON(w, http.StatusCreated, t.Topic)
+}
+
+func (t *topic) configList() []confluentcloud.TopicConfig {
+	list := make([]confluentcloud.TopicConfig, 0, len(topicDefaults))
+	for name, value := range topicDefaults {
+		config := confluentcloud.TopicConfig{Name: name, Value: value, Source: "DEFAULT_CONFIG", Default: true}
+		if v, ok := t.configs[name]; ok {
+			config.Value = v
+			config.Source = "DYNAMIC_TOPIC_CONFIG"
+			config.Default = false
+		}
+		list = append(list, config)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+func (s *Server) serveACLs(w http.ResponseWriter, r *http.Request, c *cluster) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, confluentcloud.ACLsResponse{Data: c.matchACLs(r, false)})
+	case http.MethodPost:
+		var binding confluentcloud.ACLBinding
+		if !decode(w, r, &binding) {
+			return
+		}
+		if err := binding.Validate(); err != nil {
+			writeKafkaError(w, http.StatusBadRequest, 40002, err.Error())
+			return
+		}
+		for _, acl := range c.acls {
+			if acl == binding {
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+		}
+		c.acls = append(c.acls, binding)
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		if r.URL.Query().Get("resource_type") == "" {
+			writeKafkaError(w, http.StatusBadRequest, 40002, "resource_type is required")
+			return
+		}
+		writeJSON(w, http.StatusOK, confluentcloud.ACLsResponse{Data: c.matchACLs(r, true)})
+	default:
+		writeKafkaError(w, http.StatusMethodNotAllowed, 405, fmt.Sprintf("method %s not allowed on %s", r.Method, r.URL.Path))
+	}
+}
+
+// matchACLs returns the ACLs of the cluster matched by the filter in the
+// query of r, removing them if remove is set.
+func (c *cluster) matchACLs(r *http.Request, remove bool) []confluentcloud.ACLBinding {
+	query := r.URL.Query()
+	is := func(param, value string) bool {
+		want := query.Get(param)
+		return want == "" || want == "ANY" || want == value
+	}
+
+	matched := make([]confluentcloud.ACLBinding, 0)
+	kept := c.acls[:0]
+	for _, acl := range c.acls {
+		ok := is("resource_type", string(acl.ResourceType)) &&
+			is("principal", acl.Principal) &&
+			is("host", acl.Host) &&
+			is("operation", string(acl.Operation)) &&
+			is("permission", string(acl.Permission))
+		if ok && query.Get("pattern_type") == string(confluentcloud.ACLPatternMatch) {
+			name := query.Get("resource_name")
+			switch acl.PatternType {
+			case confluentcloud.ACLPatternLiteral:
+				ok = name == "" || acl.ResourceName == name || acl.ResourceName == "*"
+			case confluentcloud.ACLPatternPrefixed:
+				ok = strings.HasPrefix(name, acl.ResourceName)
+			}
+		} else if ok {
+			ok = is("pattern_type", string(acl.PatternType)) && is("resource_name", acl.ResourceName)
+		}
+
+		if ok {
+			matched = append(matched, acl)
+		}
+		if !ok || !remove {
+			kept = append(kept, acl)
+		}
+	}
+	c.acls = kept
+	return matched
+}
+
+// writeKafkaError writes an error in the shape used by the Kafka REST API.
+func writeKafkaError(w http.ResponseWriter, status, code int, message string) {
+	writeJSON(w, status, map[string]interface{}{"error_code": code, "message": message})
+}
@@ -0,0 +1,140 @@
+package confluentcloudtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// plugin is a connector plugin of the fake, with the properties it
+// validates.
+type plugin struct {
+	confluentcloud.ConnectorPlugin
+	configs []confluentcloud.ConnectorConfigDefinition
+}
+
+// commonConfigs are the properties every plugin has.
+var commonConfigs = []confluentcloud.ConnectorConfigDefinition{
+	{Name: "name", Type: "STRING", Required: true, Importance: "HIGH", Group: "Common", DisplayName: "Connector name"},
+	{Name: "connector.class", Type: "STRING", Required: true, Importance: "HIGH", Group: "Common", DisplayName: "Connector class"},
+	{Name: "tasks.max", Type: "INT", DefaultValue: "1", Importance: "HIGH", Group: "Common", DisplayName: "Tasks"},
+}
+
+var plugins = []plugin{
+	{
+		ConnectorPlugin: confluentcloud.ConnectorPlugin{Class: "DatagenSource", Type: "source", Version: "0.6.0"},
+		configs: []confluentcloud.ConnectorConfigDefinition{
+			{Name: "kafka.topic", Type: "STRING", Required: true, Importance: "HIGH", Group: "Kafka", DisplayName: "Topic"},
+			{Name: "output.data.format", Type: "STRING", Required: true, Importance: "HIGH", Group: "Output", DisplayName: "Output format"},
+			{Name: "quickstart", Type: "STRING", Required: true, Importance: "HIGH", Group: "Datagen", DisplayName: "Quickstart"},
+		},
+	},
+	{
+		ConnectorPlugin: confluentcloud.ConnectorPlugin{Class: "S3_SINK", Type: "sink", Version: "10.0.0"},
+		configs: []confluentcloud.ConnectorConfigDefinition{
+			{Name: "topics", Type: "LIST", Required: true, Importance: "HIGH", Group: "Kafka", DisplayName: "Topics"},
+			{Name: "s3.bucket.name", Type: "STRING", Required: true, Importance: "HIGH", Group: "S3", DisplayName: "Bucket name"},
+			{Name: "input.data.format", Type: "STRING", Required: true, Importance: "HIGH", Group: "Input", DisplayName: "Input format"},
+			{Name: "output.data.format", Type: "STRING", Required: true, Importance: "HIGH", Group: "Output", DisplayName: "Output format"},
+			{Name: "time.interval", Type: "STRING", Required: true, Importance: "HIGH", Group: "Storage", DisplayName: "Time interval"},
+		},
+	},
+}
+
+// recommendedValues lists the accepted values of the properties with a fixed
+// set of choices.
+var recommendedValues = map[string][]string{
+	"output.data.format": {"AVRO", "JSON", "JSON_SR", "PROTOBUF"},
+	"input.data.format":  {"AVRO", "JSON", "JSON_SR", "PROTOBUF", "BYTES", "STRING"},
+	"quickstart":         {"CLICKSTREAM", "ORDERS", "PAGEVIEWS", "USERS"},
+	"time.interval":      {"DAILY", "HOURLY"},
+}
+
+func (s *Server) serveConnectorPlugins(w http.ResponseWriter, r *http.Request, accountID, clusterID string, path []string) {
+	if c, ok := s.clusters[clusterID]; !ok || c.AccountID != accountID {
+		writeConnectError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", clusterID))
+		return
+	}
+
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		list := make([]confluentcloud.ConnectorPlugin, len(plugins))
+		for i, p := range plugins {
+			list[i] = p.ConnectorPlugin
+		}
+		writeJSON(w, http.StatusOK, list)
+	case len(path) == 3 && path[1] == "config" && path[2] == "validate" && r.Method == http.MethodPut:
+		for _, p := range plugins {
+			if p.Class == path[0] {
+				var config confluentcloud.ConnectorConfig
+				if !decode(w, r, &config) {
+					return
+				}
+				writeJSON(w, http.StatusOK, p.validate(config))
+				return
+			}
+		}
+		writeConnectError(w, http.StatusNotFound, fmt.Sprintf("Connector plugin %s not found", path[0]))
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+// validate checks config the way Kafka Connect does: required properties
+// must be set, INT properties must be numbers and properties with
+// recommended values must use one of them.
+func (p plugin) validate(config confluentcloud.ConnectorConfig) confluentcloud.ConnectorConfigValidation {
+	result := confluentcloud.ConnectorConfigValidation{Name: p.Class}
+	groups := make(map[string]bool)
+
+	for i, definition := range append(append([]confluentcloud.ConnectorConfigDefinition{}, commonConfigs...), p.configs...) {
+		definition.Order = i + 1
+		definition.Dependents = []string{}
+		if !groups[definition.Group] {
+			groups[definition.Group] = true
+			result.Groups = append(result.Groups, definition.Group)
+		}
+
+		value, set := config[definition.Name]
+		v := confluentcloud.ConnectorConfigValue{
+			Name:              definition.Name,
+			Value:             value,
+			RecommendedValues: recommendedValues[definition.Name],
+			Errors:            []string{},
+			Visible:           true,
+		}
+		if v.RecommendedValues == nil {
+			v.RecommendedValues = []string{}
+		}
+
+		switch {
+		case !set && definition.Required:
+			v.Errors = append(v.Errors, fmt.Sprintf("Missing required configuration \"%s\" which has no default value.", definition.Name))
+		case !set:
+			v.Value = definition.DefaultValue
+		case definition.Name == "connector.class" && value != p.Class:
+			v.Errors = append(v.Errors, fmt.Sprintf("Invalid value %s for configuration connector.class: expected %s", value, p.Class))
+		case definition.Type == "INT":
+			if _, err := strconv.Atoi(value); err != nil {
+				v.Errors = append(v.Errors, fmt.Sprintf("Invalid value %s for configuration %s: Not a number of type INT", value, definition.Name))
+			}
+		case len(v.RecommendedValues) > 0 && !contains(v.RecommendedValues, value):
+			v.Errors = append(v.Errors, fmt.Sprintf("Invalid value %s for configuration %s: must be one of %v", value, definition.Name, v.RecommendedValues))
+		}
+
+		result.ErrorCount += len(v.Errors)
+		result.Configs = append(result.Configs, confluentcloud.ConnectorConfigInfo{Definition: definition, Value: v})
+	}
+	return result
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,661 @@
+package confluentcloudtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+func (s *Server) serveEnvironments(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]confluentcloud.Environment, 0, len(s.environments))
+			for _, env := range s.environments {
+				list = append(list, *env)
+			}
+			sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+			writeJSON(w, http.StatusOK, confluentcloud.EnvironmentsResponse{Accounts: list})
+		case http.MethodPost:
+			var req confluentcloud.EnvironmentCreateRequest
+			if !decode(w, r, &req) || !s.validEnvironment(w, "", req.Account) {
+				return
+			}
+			env := &confluentcloud.Environment{
+				ID:             s.newID("env"),
+				Name:           req.Account.Name,
+				OrganizationID: req.Account.OrganizationID,
+			}
+			s.environments[env.ID] = env
+			writeJSON(w, http.StatusCreated, confluentcloud.EnvironmentResponse{Account: *env})
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	env, ok := s.environments[path[0]]
+	if !ok || len(path) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("environment %s not found", path[0]))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, confluentcloud.EnvironmentResponse{Account: *env})
+	case http.MethodPut:
+		var req confluentcloud.EnvironmentCreateRequest
+		if !decode(w, r, &req) || !s.validEnvironment(w, env.ID, req.Account) {
+			return
+		}
+		env.Name = req.Account.Name
+		writeJSON(w, http.StatusOK, confluentcloud.EnvironmentResponse{Account: *env})
+	case http.MethodDelete:
+		for _, c := range s.clusters {
+			if c.AccountID == env.ID {
+				writeError(w, http.StatusConflict, fmt.Sprintf("environment %s still has clusters", env.ID))
+				return
+			}
+		}
+		delete(s.environments, env.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) validEnvironment(w http.ResponseWriter, id string, req confluentcloud.EnvironmentRequest) bool {
+	if req.Name == "" {
+		writeValidationError(w, map[string]string{"name": "must not be empty"})
+		return false
+	}
+	for _, env := range s.environments {
+		if env.Name == req.Name && env.ID != id {
+			writeError(w, http.StatusConflict, fmt.Sprintf("environment %q already exists", req.Name))
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) serveClusters(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listClusters(w, r)
+		case http.MethodPost:
+			s.createCluster(w, r)
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	c, ok := s.clusters[path[0]]
+	if !ok || len(path) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", path[0]))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if c.AccountID != r.URL.Query().Get("account_id") {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", c.ID))
+			return
+		}
+		if c.pendingPolls > 0 {
+			c.pendingPolls--
+			if c.pendingPolls == 0 {
+				s.clusterUp(c)
+			}
+		}
+		writeJSON(w, http.StatusOK, confluentcloud.ClusterResponse{Cluster: c.Cluster})
+	case http.MethodPut:
+		var req confluentcloud.ClusterResponse
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Cluster.Name == "" {
+			writeValidationError(w, map[string]string{"name": "must not be empty"})
+			return
+		}
+		c.Name = req.Cluster.Name
+		c.Storage = req.Cluster.Storage
+		c.NetworkIngress = req.Cluster.NetworkIngress
+		c.NetworkEgress = req.Cluster.NetworkEgress
+		c.Durability = req.Cluster.Durability
+		if req.Cluster.Cku != c.Cku {
+			// Resizing takes as long as provisioning.
+			c.Cku = req.Cluster.Cku
+			if s.ProvisioningPolls > 0 {
+				c.Status = "PROVISIONING"
+				c.pendingPolls = s.ProvisioningPolls
+			}
+		}
+		writeJSON(w, http.StatusOK, confluentcloud.ClusterResponse{Cluster: c.Cluster})
+	case http.MethodDelete:
+		var req struct {
+			Cluster struct {
+				AccountID string `json:"accountId"`
+			} `json:"cluster"`
+		}
+		if !decode(w, r, &req) {
+			return
+		}
+		if c.AccountID != req.Cluster.AccountID {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", c.ID))
+			return
+		}
+		delete(s.clusters, c.ID)
+		delete(s.connectors, c.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	accountID := r.URL.Query().Get("account_id")
+	list := make([]confluentcloud.Cluster, 0)
+	for _, c := range s.clusters {
+		if c.AccountID == accountID {
+			list = append(list, c.Cluster)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	writeJSON(w, http.StatusOK, confluentcloud.ClustersResponse{Clusters: list})
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	var req confluentcloud.ClusterCreateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	config := req.Config
+	invalid := make(map[string]string)
+	if config.Name == "" {
+		invalid["name"] = "must not be empty"
+	}
+	if config.Region == "" {
+		invalid["region"] = "must not be empty"
+	}
+	if config.ServiceProvider == "" {
+		invalid["serviceProvider"] = "must not be empty"
+	}
+	if len(invalid) > 0 {
+		writeValidationError(w, invalid)
+		return
+	}
+	if _, ok := s.environments[config.AccountID]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("environment %s not found", config.AccountID))
+		return
+	}
+
+	durability := string(config.Durability)
+	if durability == "" {
+		durability = "LOW"
+	}
+	now := time.Now().UTC()
+	c := &cluster{
+		Cluster: confluentcloud.Cluster{
+			ID:              s.newID("lkc"),
+			Name:            config.Name,
+			AccountID:       config.AccountID,
+			NetworkIngress:  config.NetworkIngress,
+			NetworkEgress:   config.NetworkEgress,
+			Storage:         config.Storage,
+			Durability:      durability,
+			Status:          "PROVISIONING",
+			Region:          config.Region,
+			ServiceProvider: string(config.ServiceProvider),
+			OrganizationID:  OrganizationID,
+			Type:            strings.ToLower(string(config.Deployment.Sku)),
+			Dedicated:       strings.EqualFold(string(config.Deployment.Sku), "dedicated"),
+			Cku:             config.Cku,
+			Deployment: confluentcloud.ClusterDeployment{
+				ID:        s.newID("dep"),
+				Created:   now,
+				Modified:  now,
+				AccountID: config.AccountID,
+				Sku:       string(config.Deployment.Sku),
+			},
+		},
+		pendingPolls: s.ProvisioningPolls,
+	}
+	if config.Deployment.NetworkAccess != nil {
+		c.Deployment.NetworkAccess = *config.Deployment.NetworkAccess
+	} else {
+		c.Deployment.NetworkAccess.PublicInternet = []confluentcloud.PublicInternetAccess{{}}
+	}
+	if c.pendingPolls == 0 {
+		s.clusterUp(c)
+	}
+	s.clusters[c.ID] = c
+	writeJSON(w, http.StatusCreated, confluentcloud.ClusterResponse{Cluster: c.Cluster})
+}
+
+func (s *Server) clusterUp(c *cluster) {
+	host := fmt.Sprintf("%s.%s.%s.confluent.cloud", strings.Replace(c.ID, "lkc", "pkc", 1), c.Region, c.ServiceProvider)
+	c.Status = "UP"
+	c.Endpoint = fmt.Sprintf("SASL_SSL://%s:9092", host)
+	c.APIEndpoint = s.URL
+}
+
+func (s *Server) serveAPIKeys(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			query := r.URL.Query()
+			list := make([]confluentcloud.APIKey, 0)
+			for _, key := range s.apiKeys {
+				if key.AccountID == query.Get("account_id") && hasLogicalCluster(key, query.Get("cluster_id")) {
+					listed := *key
+					listed.Secret = ""
+					list = append(list, listed)
+				}
+			}
+			sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+			writeJSON(w, http.StatusOK, confluentcloud.APIKeysResponse{APIKeys: list})
+		case http.MethodPost:
+			s.createAPIKey(w, r)
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	id, err := strconv.Atoi(path[0])
+	key, ok := s.apiKeys[id]
+	if err != nil || !ok || len(path) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("api key %s not found", path[0]))
+		return
+	}
+	if r.Method != http.MethodDelete {
+		methodNotAllowed(w, r)
+		return
+	}
+
+	var req struct {
+		APIKey struct {
+			AccountID string `json:"accountId"`
+		} `json:"api_key"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if key.AccountID != req.APIKey.AccountID {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("api key %s not found", path[0]))
+		return
+	}
+	delete(s.apiKeys, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func hasLogicalCluster(key *confluentcloud.APIKey, clusterID string) bool {
+	if clusterID == "" {
+		return true
+	}
+	for _, lc := range key.LogicalClusters {
+		if lc.ID == clusterID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) createAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req confluentcloud.ApiKeyCreateRequestW
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.APIKey == nil || len(req.APIKey.LogicalClusters) == 0 {
+		writeValidationError(w, map[string]string{"logical_clusters": "must not be empty"})
+		return
+	}
+	for _, lc := range req.APIKey.LogicalClusters {
+		if sr, ok := s.schemaRegistry(lc.ID); ok && sr.AccountID == req.APIKey.AccountID {
+			continue
+		}
+		if c, ok := s.clusters[lc.ID]; !ok || c.AccountID != req.APIKey.AccountID {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", lc.ID))
+			return
+		}
+	}
+	if req.APIKey.UserID != 0 {
+		if _, ok := s.serviceAccounts[req.APIKey.UserID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("service account %d not found", req.APIKey.UserID))
+			return
+		}
+	}
+
+	key := &confluentcloud.APIKey{
+		ID:              s.newIntID(),
+		Key:             strings.ToUpper(randomHex(16)),
+		Secret:          randomHex(64),
+		SASLMechanism:   "PLAIN",
+		UserID:          req.APIKey.UserID,
+		Description:     req.APIKey.Description,
+		LogicalClusters: req.APIKey.LogicalClusters,
+		AccountID:       req.APIKey.AccountID,
+		ServiceAccount:  req.APIKey.UserID != 0,
+	}
+	s.apiKeys[key.ID] = key
+	writeJSON(w, http.StatusCreated, confluentcloud.APIKeyResponse{APIKey: *key})
+}
+
+func (s *Server) serveServiceAccounts(w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) > 0 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		list := make([]confluentcloud.ServiceAccount, 0, len(s.serviceAccounts))
+		for _, sa := range s.serviceAccounts {
+			list = append(list, *sa)
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+		writeJSON(w, http.StatusOK, confluentcloud.ServiceAccountsResponse{ServiceAccounts: list})
+	case http.MethodPost:
+		var req confluentcloud.ServiceAccountCreateRequestW
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.ServiceAccount == nil || req.ServiceAccount.Name == "" {
+			writeValidationError(w, map[string]string{"service_name": "must not be empty"})
+			return
+		}
+		for _, sa := range s.serviceAccounts {
+			if sa.Name == req.ServiceAccount.Name {
+				writeError(w, http.StatusConflict, fmt.Sprintf("service account %q already exists", sa.Name))
+				return
+			}
+		}
+		sa := &confluentcloud.ServiceAccount{
+			ID:          s.newIntID(),
+			Name:        req.ServiceAccount.Name,
+			Description: req.ServiceAccount.Description,
+		}
+		s.serviceAccounts[sa.ID] = sa
+		writeJSON(w, http.StatusCreated, confluentcloud.ServiceAccountResponse{ServiceAccount: *sa})
+	case http.MethodDelete:
+		var req confluentcloud.ServiceAccountDeleteRequestW
+		if !decode(w, r, &req) {
+			return
+		}
+		if _, ok := s.serviceAccounts[req.ServiceAccount.ID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("service account %d not found", req.ServiceAccount.ID))
+			return
+		}
+		delete(s.serviceAccounts, req.ServiceAccount.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveSchemaRegistries(w http.ResponseWriter, r *http.Request, path []string) {
+	accountID := r.URL.Query().Get("account_id")
+	if _, ok := s.environments[accountID]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("environment %s not found", accountID))
+		return
+	}
+
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]confluentcloud.SchemaRegistry, 0)
+			for _, sr := range s.schemaRegistries {
+				if sr.AccountID == accountID {
+					list = append(list, *sr)
+				}
+			}
+			sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+			writeJSON(w, http.StatusOK, confluentcloud.SchemaRegistryResponse{Clusters: list})
+		case http.MethodPost:
+			s.createSchemaRegistry(w, r, accountID)
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	sr, ok := s.schemaRegistries[path[0]]
+	if !ok || sr.AccountID != accountID || len(path) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("schema registry %s not found", path[0]))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, confluentcloud.SchemaRegistryCreateResponse{Cluster: *sr})
+	case http.MethodDelete:
+		delete(s.schemaRegistries, sr.ID)
+		delete(s.schemaStores, sr.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) createSchemaRegistry(w http.ResponseWriter, r *http.Request, accountID string) {
+	var req confluentcloud.SchemaRegistryCreateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	config := req.Config
+	invalid := make(map[string]string)
+	if config.Name == "" {
+		invalid["name"] = "must not be empty"
+	}
+	if config.Location == "" {
+		invalid["location"] = "must not be empty"
+	}
+	if config.ServiceProvider == "" {
+		invalid["service_provider"] = "must not be empty"
+	}
+	if len(invalid) > 0 {
+		writeValidationError(w, invalid)
+		return
+	}
+	if config.KafkaClusterID != "" {
+		if c, ok := s.clusters[config.KafkaClusterID]; !ok || c.AccountID != accountID {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", config.KafkaClusterID))
+			return
+		}
+	}
+	for _, sr := range s.schemaRegistries {
+		if sr.AccountID == accountID && sr.Name == config.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("schema registry %q already exists", config.Name))
+			return
+		}
+	}
+
+	pkg := config.Package
+	if pkg == "" {
+		pkg = "ESSENTIALS"
+	}
+	now := time.Now().UTC()
+	id := s.newID("lsrc")
+	sr := &confluentcloud.SchemaRegistry{
+		ID:                id,
+		Name:              config.Name,
+		KafkaClusterID:    config.KafkaClusterID,
+		Endpoint:          fmt.Sprintf("%s/schema-registry/%s", s.URL, id),
+		Created:           now,
+		Modified:          now,
+		Status:            "UP",
+		PhysicalClusterID: strings.Replace(id, "lsrc", "psrc", 1),
+		AccountID:         accountID,
+		OrganizationID:    OrganizationID,
+		MaxSchemas:        1000,
+		Package:           pkg,
+	}
+	s.schemaRegistries[sr.ID] = sr
+	writeJSON(w, http.StatusCreated, confluentcloud.SchemaRegistryCreateResponse{Cluster: *sr})
+}
+
+// serveConnectors mimics the Kafka Connect REST API, which reports errors
+// as a bare ErrorMessage.
+func (s *Server) serveConnectors(w http.ResponseWriter, r *http.Request, accountID, clusterID string, path []string) {
+	if c, ok := s.clusters[clusterID]; !ok || c.AccountID != accountID {
+		writeConnectError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", clusterID))
+		return
+	}
+	connectors := s.connectors[clusterID]
+	if connectors == nil {
+		connectors = make(map[string]*connector)
+		s.connectors[clusterID] = connectors
+	}
+
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make(map[string]confluentcloud.Connector, len(connectors))
+			for name, connector := range connectors {
+				list[name] = connector.Connector
+			}
+			writeJSON(w, http.StatusOK, list)
+		case http.MethodPost:
+			var req confluentcloud.CreateConnectorRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if _, ok := connectors[req.Name]; ok {
+				writeConnectError(w, http.StatusConflict, fmt.Sprintf("Connector %s already exists", req.Name))
+				return
+			}
+			c, ok := s.newConnector(w, req.Name, req.Config)
+			if !ok {
+				return
+			}
+			connectors[req.Name] = c
+			writeJSON(w, http.StatusCreated, c.Info)
+		default:
+			methodNotAllowed(w, r)
+		}
+		return
+	}
+
+	c, ok := connectors[path[0]]
+	if !ok {
+		writeConnectError(w, http.StatusNotFound, fmt.Sprintf("Connector %s not found", path[0]))
+		return
+	}
+
+	switch {
+	case len(path) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, c.Info)
+	case len(path) == 1 && r.Method == http.MethodDelete:
+		delete(connectors, path[0])
+		w.WriteHeader(http.StatusNoContent)
+	case len(path) == 2 && path[1] == "config" && r.Method == http.MethodPut:
+		var config confluentcloud.ConnectorConfig
+		if !decode(w, r, &config) {
+			return
+		}
+		updated, ok := s.newConnector(w, c.Info.Name, config)
+		if !ok {
+			return
+		}
+		updated.ID = c.ID
+		connectors[path[0]] = updated
+		writeJSON(w, http.StatusOK, updated.Info)
+	case len(path) == 2 && path[1] == "status" && r.Method == http.MethodGet:
+		if c.pendingPolls > 0 {
+			c.pendingPolls--
+			if c.pendingPolls == 0 {
+				c.setState(confluentcloud.ConnectorStateRunning)
+			}
+		}
+		writeJSON(w, http.StatusOK, c.status)
+	case len(path) == 2 && (path[1] == "pause" || path[1] == "resume") && r.Method == http.MethodPut:
+		if c.status.Connector.State == confluentcloud.ConnectorStateProvisioning {
+			writeConnectError(w, http.StatusConflict, fmt.Sprintf("Connector %s is still being provisioned", c.Info.Name))
+			return
+		}
+		if path[1] == "pause" {
+			c.setState(confluentcloud.ConnectorStatePaused)
+		} else {
+			c.setState(confluentcloud.ConnectorStateRunning)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	case len(path) == 2 && path[1] == "restart" && r.Method == http.MethodPost:
+		c.status.Connector = confluentcloud.ConnectorStateInfo{State: confluentcloud.ConnectorStateRunning, WorkerID: c.status.Connector.WorkerID}
+		w.WriteHeader(http.StatusNoContent)
+	case len(path) == 4 && path[1] == "tasks" && path[3] == "restart" && r.Method == http.MethodPost:
+		n, err := strconv.Atoi(path[2])
+		if err != nil || n < 0 || n >= len(c.status.Tasks) {
+			writeConnectError(w, http.StatusNotFound, fmt.Sprintf("Task %s-%s not found", c.Info.Name, path[2]))
+			return
+		}
+		c.status.Tasks[n].State = confluentcloud.ConnectorStateRunning
+		c.status.Tasks[n].Trace = ""
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) newConnector(w http.ResponseWriter, name string, config confluentcloud.ConnectorConfig) (*connector, bool) {
+	class := config["connector.class"]
+	if name == "" || class == "" {
+		writeConnectError(w, http.StatusBadRequest, "Connector name and connector.class are required")
+		return nil, false
+	}
+
+	connectorType := "sink"
+	if strings.Contains(class, "Source") {
+		connectorType = "source"
+	}
+
+	stored := make(confluentcloud.ConnectorConfig, len(config)+1)
+	for k, v := range config {
+		stored[k] = v
+	}
+	stored["name"] = name
+
+	c := &connector{
+		Connector: confluentcloud.Connector{
+			ID: confluentcloud.ConnectorID{ID: s.newID("lcc"), IDType: "ID"},
+			Info: confluentcloud.ConnectorInfo{
+				Name:   name,
+				Type:   connectorType,
+				Config: stored,
+				Tasks:  []confluentcloud.ConnectorTask{{ConnectorName: name, TaskNo: 0}},
+			},
+		},
+		pendingPolls: s.ProvisioningPolls,
+		status: confluentcloud.ConnectorStatus{
+			Name:  name,
+			Type:  connectorType,
+			Tasks: []confluentcloud.ConnectorTaskStatus{{ID: 0}},
+		},
+	}
+	if c.pendingPolls > 0 {
+		c.setState(confluentcloud.ConnectorStateProvisioning)
+	} else {
+		c.setState(confluentcloud.ConnectorStateRunning)
+	}
+	return c, true
+}
+
+// setState moves the connector and its tasks to state.
+func (c *connector) setState(state confluentcloud.ConnectorState) {
+	c.status.Connector = confluentcloud.ConnectorStateInfo{State: state, WorkerID: c.ID.ID}
+	for i := range c.status.Tasks {
+		c.status.Tasks[i] = confluentcloud.ConnectorTaskStatus{ID: i, State: state, WorkerID: c.ID.ID}
+	}
+}
+
+func writeConnectError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, confluentcloud.ErrorMessage{Code: status, Message: message})
+}
@@ -0,0 +1,464 @@
+package confluentcloudtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/schemacompat"
+)
+
+// schemaStore holds the schemas of one Schema Registry.
+type schemaStore struct {
+	// schemas is indexed by schema ID minus one.
+	schemas  []confluentcloud.Schema
+	subjects map[string][]*subjectVersion
+
+	compatibility        confluentcloud.CompatibilityLevel
+	mode                 confluentcloud.SchemaRegistryMode
+	subjectCompatibility map[string]confluentcloud.CompatibilityLevel
+	subjectModes         map[string]confluentcloud.SchemaRegistryMode
+}
+
+func newSchemaStore() *schemaStore {
+	return &schemaStore{
+		subjects:             make(map[string][]*subjectVersion),
+		compatibility:        confluentcloud.CompatibilityBackward,
+		mode:                 confluentcloud.ModeReadWrite,
+		subjectCompatibility: make(map[string]confluentcloud.CompatibilityLevel),
+		subjectModes:         make(map[string]confluentcloud.SchemaRegistryMode),
+	}
+}
+
+type subjectVersion struct {
+	version int
+	id      int
+	deleted bool
+}
+
+func (s *Server) schemaRegistry(id string) (*confluentcloud.SchemaRegistry, bool) {
+	sr, ok := s.schemaRegistries[id]
+	return sr, ok
+}
+
+// serveSchemas serves the REST API of a Schema Registry. Requests must be
+// authenticated with an API key of the registry. Like the real registry, it
+// reports errors in the shape of the Kafka REST API.
+func (s *Server) serveSchemas(w http.ResponseWriter, r *http.Request, registryID string, path []string) {
+	if _, ok := s.schemaRegistry(registryID); !ok {
+		writeKafkaError(w, http.StatusNotFound, 404, fmt.Sprintf("schema registry %s not found", registryID))
+		return
+	}
+	if !s.authenticatedForCluster(r, registryID) {
+		writeKafkaError(w, http.StatusUnauthorized, 401, "Unauthorized")
+		return
+	}
+	store := s.schemaStores[registryID]
+	if store == nil {
+		store = newSchemaStore()
+		s.schemaStores[registryID] = store
+	}
+
+	query := r.URL.Query()
+	switch {
+	case len(path) == 1 && path[0] == "subjects" && r.Method == http.MethodGet:
+		subjects := make([]string, 0, len(store.subjects))
+		for subject, versions := range store.subjects {
+			if len(store.visible(versions, query.Get("deleted") == "true")) > 0 {
+				subjects = append(subjects, subject)
+			}
+		}
+		sort.Strings(subjects)
+		writeJSON(w, http.StatusOK, subjects)
+	case len(path) == 2 && path[0] == "subjects" && r.Method == http.MethodDelete:
+		if !store.writable(w, path[1]) {
+			return
+		}
+		s.deleteSubject(w, store, path[1], query.Get("permanent") == "true")
+	case len(path) == 3 && path[0] == "subjects" && path[2] == "versions":
+		switch r.Method {
+		case http.MethodGet:
+			versions, ok := store.subject(w, path[1], query.Get("deleted") == "true")
+			if !ok {
+				return
+			}
+			list := make([]int, len(versions))
+			for i, v := range versions {
+				list[i] = v.version
+			}
+			writeJSON(w, http.StatusOK, list)
+		case http.MethodPost:
+			if !store.writable(w, path[1]) {
+				return
+			}
+			s.registerSchema(w, r, store, path[1])
+		default:
+			methodNotAllowed(w, r)
+		}
+	case len(path) == 4 && path[0] == "subjects" && path[2] == "versions":
+		v, ok := store.version(w, path[1], path[3], r.Method == http.MethodDelete)
+		if !ok {
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			schema := store.schemas[v.id-1]
+			schema.Subject = path[1]
+			schema.Version = v.version
+			schema.ID = v.id
+			writeJSON(w, http.StatusOK, schema)
+		case http.MethodDelete:
+			if !store.writable(w, path[1]) {
+				return
+			}
+			s.deleteVersion(w, store, path[1], v, query.Get("permanent") == "true")
+		default:
+			methodNotAllowed(w, r)
+		}
+	case len(path) == 3 && path[0] == "schemas" && path[1] == "ids" && r.Method == http.MethodGet:
+		id, err := strconv.Atoi(path[2])
+		if err != nil || id < 1 || id > len(store.schemas) {
+			writeKafkaError(w, http.StatusNotFound, 40403, "Schema not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, store.schemas[id-1])
+	case len(path) >= 1 && len(path) <= 2 && path[0] == "config":
+		s.serveSchemaConfig(w, r, store, path[1:])
+	case len(path) >= 1 && len(path) <= 2 && path[0] == "mode":
+		s.serveSchemaMode(w, r, store, path[1:])
+	case len(path) == 5 && path[0] == "compatibility" && path[1] == "subjects" && path[3] == "versions" && r.Method == http.MethodPost:
+		s.testCompatibility(w, r, store, path[2], path[4])
+	default:
+		writeKafkaError(w, http.StatusNotFound, 404, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+	}
+}
+
+// visible returns the versions that are not soft-deleted, or all of them if
+// deleted is set.
+func (store *schemaStore) visible(versions []*subjectVersion, deleted bool) []*subjectVersion {
+	list := make([]*subjectVersion, 0, len(versions))
+	for _, v := range versions {
+		if deleted || !v.deleted {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func (store *schemaStore) subject(w http.ResponseWriter, subject string, deleted bool) ([]*subjectVersion, bool) {
+	versions := store.visible(store.subjects[subject], deleted)
+	if len(versions) == 0 {
+		writeKafkaError(w, http.StatusNotFound, 40401, fmt.Sprintf("Subject '%s' not found.", subject))
+		return nil, false
+	}
+	return versions, true
+}
+
+// version looks up a version of subject, where "latest" and -1 address the
+// latest one.
+func (store *schemaStore) version(w http.ResponseWriter, subject, version string, deleted bool) (*subjectVersion, bool) {
+	versions, ok := store.subject(w, subject, deleted)
+	if !ok {
+		return nil, false
+	}
+	if version == "latest" || version == "-1" {
+		return versions[len(versions)-1], true
+	}
+
+	n, err := strconv.Atoi(version)
+	if err != nil || n < 1 {
+		writeKafkaError(w, http.StatusUnprocessableEntity, 42202, fmt.Sprintf("Invalid version %s", version))
+		return nil, false
+	}
+	for _, v := range versions {
+		if v.version == n {
+			return v, true
+		}
+	}
+	writeKafkaError(w, http.StatusNotFound, 40402, fmt.Sprintf("Version %d not found.", n))
+	return nil, false
+}
+
+func (s *Server) registerSchema(w http.ResponseWriter, r *http.Request, store *schemaStore, subject string) {
+	var req confluentcloud.Schema
+	if !decode(w, r, &req) || !validSchema(w, req) {
+		return
+	}
+	if req.SchemaType == confluentcloud.SchemaTypeAvro {
+		req.SchemaType = ""
+	}
+
+	id := 0
+	for i, schema := range store.schemas {
+		if schema.Schema == req.Schema && schema.SchemaType == req.SchemaType {
+			id = i + 1
+		}
+	}
+
+	versions := store.subjects[subject]
+	for _, v := range versions {
+		if v.id == id && !v.deleted {
+			writeJSON(w, http.StatusOK, confluentcloud.RegisterSchemaResponse{ID: id})
+			return
+		}
+	}
+	if store.subjectMode(subject) != confluentcloud.ModeImport {
+		report, ok := store.check(w, subject, req, store.visible(versions, false))
+		if !ok {
+			return
+		}
+		if !report.Compatible() {
+			writeKafkaError(w, http.StatusConflict, 409, "Schema being registered is incompatible with an earlier schema: "+strings.Join(report.Messages(), "; "))
+			return
+		}
+	}
+	if id == 0 {
+		store.schemas = append(store.schemas, confluentcloud.Schema{Schema: req.Schema, SchemaType: req.SchemaType, References: req.References})
+		id = len(store.schemas)
+	}
+
+	next := 1
+	if len(versions) > 0 {
+		next = versions[len(versions)-1].version + 1
+	}
+	store.subjects[subject] = append(versions, &subjectVersion{version: next, id: id})
+	writeJSON(w, http.StatusOK, confluentcloud.RegisterSchemaResponse{ID: id})
+}
+
+// validSchema rejects empty schemas and, as the registry cannot parse them,
+// Avro and JSON schemas that are not JSON.
+func validSchema(w http.ResponseWriter, schema confluentcloud.Schema) bool {
+	invalid := schema.Schema == ""
+	switch schema.SchemaType {
+	case "", confluentcloud.SchemaTypeAvro, confluentcloud.SchemaTypeJSON:
+		invalid = invalid || !json.Valid([]byte(schema.Schema))
+	case confluentcloud.SchemaTypeProtobuf:
+	default:
+		writeKafkaError(w, http.StatusUnprocessableEntity, 42201, fmt.Sprintf("Invalid schema type %s", schema.SchemaType))
+		return false
+	}
+	if invalid {
+		writeKafkaError(w, http.StatusUnprocessableEntity, 42201, "Invalid schema")
+		return false
+	}
+	return true
+}
+
+func (s *Server) deleteVersion(w http.ResponseWriter, store *schemaStore, subject string, v *subjectVersion, permanent bool) {
+	switch {
+	case permanent && !v.deleted:
+		writeKafkaError(w, http.StatusNotFound, 40407, fmt.Sprintf("Subject '%s' Version %d was not deleted first before being permanently deleted", subject, v.version))
+		return
+	case permanent:
+		versions := store.subjects[subject]
+		for i := range versions {
+			if versions[i] == v {
+				store.subjects[subject] = append(versions[:i], versions[i+1:]...)
+				break
+			}
+		}
+		if len(store.subjects[subject]) == 0 {
+			delete(store.subjects, subject)
+		}
+	case v.deleted:
+		writeKafkaError(w, http.StatusNotFound, 40406, fmt.Sprintf("Subject '%s' Version %d was soft deleted. Set permanent=true to delete permanently", subject, v.version))
+		return
+	default:
+		v.deleted = true
+	}
+	writeJSON(w, http.StatusOK, v.version)
+}
+
+func (s *Server) deleteSubject(w http.ResponseWriter, store *schemaStore, subject string, permanent bool) {
+	versions, ok := store.subject(w, subject, permanent)
+	if !ok {
+		return
+	}
+
+	list := make([]int, 0, len(versions))
+	for _, v := range versions {
+		if permanent && !v.deleted {
+			writeKafkaError(w, http.StatusNotFound, 40405, fmt.Sprintf("Subject '%s' was not deleted first before being permanently deleted", subject))
+			return
+		}
+		list = append(list, v.version)
+	}
+	if permanent {
+		delete(store.subjects, subject)
+	} else {
+		for _, v := range versions {
+			v.deleted = true
+		}
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+// testCompatibility checks the schema against a version of subject under the
+// compatibility level of the subject.
+func (s *Server) testCompatibility(w http.ResponseWriter, r *http.Request, store *schemaStore, subject, version string) {
+	var req confluentcloud.Schema
+	if !decode(w, r, &req) || !validSchema(w, req) {
+		return
+	}
+	v, ok := store.version(w, subject, version, false)
+	if !ok {
+		return
+	}
+	report, ok := store.check(w, subject, req, []*subjectVersion{v})
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, confluentcloud.CompatibilityResult{IsCompatible: report.Compatible(), Messages: report.Messages()})
+}
+
+// check checks schema against versions of subject with schemacompat, as the
+// registry would. Schemas it cannot parse are rejected as invalid.
+func (store *schemaStore) check(w http.ResponseWriter, subject string, schema confluentcloud.Schema, versions []*subjectVersion) (*schemacompat.Report, bool) {
+	level, ok := store.subjectCompatibility[subject]
+	if !ok {
+		level = store.compatibility
+	}
+
+	previous := make([]confluentcloud.Schema, len(versions))
+	for i, v := range versions {
+		previous[i] = store.schemas[v.id-1]
+		previous[i].Version = v.version
+	}
+	report, err := schemacompat.Check(level, schema, previous)
+	if err != nil {
+		writeKafkaError(w, http.StatusUnprocessableEntity, 42201, "Invalid schema: "+err.Error())
+		return nil, false
+	}
+	return report, true
+}
+
+// writable rejects changes to subject while it is read-only.
+func (store *schemaStore) writable(w http.ResponseWriter, subject string) bool {
+	if store.subjectMode(subject) == confluentcloud.ModeReadOnly {
+		writeKafkaError(w, http.StatusUnprocessableEntity, 42205, fmt.Sprintf("Subject %s is in read-only mode", subject))
+		return false
+	}
+	return true
+}
+
+// subjectMode returns the mode of subject, which defaults to the global one.
+func (store *schemaStore) subjectMode(subject string) confluentcloud.SchemaRegistryMode {
+	if mode, ok := store.subjectModes[subject]; ok {
+		return mode
+	}
+	return store.mode
+}
+
+func (s *Server) serveSchemaConfig(w http.ResponseWriter, r *http.Request, store *schemaStore, path []string) {
+	switch r.Method {
+	case http.MethodGet:
+		level := store.compatibility
+		if len(path) == 1 {
+			subjectLevel, ok := store.subjectCompatibility[path[0]]
+			if !ok && r.URL.Query().Get("defaultToGlobal") != "true" {
+				writeKafkaError(w, http.StatusNotFound, 40408, fmt.Sprintf("Subject '%s' does not have subject-level compatibility configured", path[0]))
+				return
+			}
+			if ok {
+				level = subjectLevel
+			}
+		}
+		writeJSON(w, http.StatusOK, confluentcloud.CompatibilityConfig{CompatibilityLevel: level})
+	case http.MethodPut:
+		var req confluentcloud.CompatibilityConfig
+		if !decode(w, r, &req) {
+			return
+		}
+		switch req.Compatibility {
+		case confluentcloud.CompatibilityNone, confluentcloud.CompatibilityBackward, confluentcloud.CompatibilityBackwardTransitive,
+			confluentcloud.CompatibilityForward, confluentcloud.CompatibilityForwardTransitive,
+			confluentcloud.CompatibilityFull, confluentcloud.CompatibilityFullTransitive:
+		default:
+			writeKafkaError(w, http.StatusUnprocessableEntity, 42203, "Invalid compatibility level")
+			return
+		}
+		if len(path) == 1 {
+			store.subjectCompatibility[path[0]] = req.Compatibility
+		} else {
+			store.compatibility = req.Compatibility
+		}
+		writeJSON(w, http.StatusOK, confluentcloud.CompatibilityConfig{Compatibility: req.Compatibility})
+	case http.MethodDelete:
+		if len(path) == 0 {
+			methodNotAllowed(w, r)
+			return
+		}
+		level, ok := store.subjectCompatibility[path[0]]
+		if !ok {
+			writeKafkaError(w, http.StatusNotFound, 40401, fmt.Sprintf("Subject '%s' not found.", path[0]))
+			return
+		}
+		delete(store.subjectCompatibility, path[0])
+		writeJSON(w, http.StatusOK, confluentcloud.CompatibilityConfig{CompatibilityLevel: level})
+	default:
+		methodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveSchemaMode(w http.ResponseWriter, r *http.Request, store *schemaStore, path []string) {
+	switch r.Method {
+	case http.MethodGet:
+		mode := store.mode
+		if len(path) == 1 {
+			subjectMode, ok := store.subjectModes[path[0]]
+			if !ok && r.URL.Query().Get("defaultToGlobal") != "true" {
+				writeKafkaError(w, http.StatusNotFound, 40409, fmt.Sprintf("Subject '%s' does not have subject-level mode configured", path[0]))
+				return
+			}
+			if ok {
+				mode = subjectMode
+			}
+		}
+		writeJSON(w, http.StatusOK, confluentcloud.ModeConfig{Mode: mode})
+	case http.MethodPut:
+		var req confluentcloud.ModeConfig
+		if !decode(w, r, &req) {
+			return
+		}
+		switch req.Mode {
+		case confluentcloud.ModeReadWrite, confluentcloud.ModeReadOnly:
+		case confluentcloud.ModeImport:
+			// Import mode would let existing IDs be overwritten.
+			empty := len(store.subjects) == 0
+			if len(path) == 1 {
+				empty = len(store.subjects[path[0]]) == 0
+			}
+			if !empty && r.URL.Query().Get("force") != "true" {
+				writeKafkaError(w, http.StatusUnprocessableEntity, 42205, "Cannot import since found existing subjects")
+				return
+			}
+		default:
+			writeKafkaError(w, http.StatusUnprocessableEntity, 42204, "Invalid mode")
+			return
+		}
+		if len(path) == 1 {
+			store.subjectModes[path[0]] = req.Mode
+		} else {
+			store.mode = req.Mode
+		}
+		writeJSON(w, http.StatusOK, req)
+	case http.MethodDelete:
+		if len(path) == 0 {
+			methodNotAllowed(w, r)
+			return
+		}
+		mode, ok := store.subjectModes[path[0]]
+		if !ok {
+			writeKafkaError(w, http.StatusNotFound, 40401, fmt.Sprintf("Subject '%s' not found.", path[0]))
+			return
+		}
+		delete(store.subjectModes, path[0])
+		writeJSON(w, http.StatusOK, confluentcloud.ModeConfig{Mode: mode})
+	default:
+		methodNotAllowed(w, r)
+	}
+}
@@ -0,0 +1,349 @@
+// Package confluentcloudtest provides an in-process fake of the Confluent
+// Cloud API for testing code that uses the confluentcloud package.
+//
+// The fake keeps all state in memory and implements the sessions, me,
+// accounts, clusters, api_keys, service_accounts, schema_registries,
+// connectors and iam/v2 endpoints closely enough for create, get, list,
+// update and delete flows, including the error responses of the real API.
+// It also serves the Kafka REST API of its clusters and the REST API of its
+// schema registries, whose endpoints point back to the Server.
+//
+//	srv := confluentcloudtest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.NewClient()
+//	if err := client.Login(); err != nil {
+//		...
+//	}
+package confluentcloudtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+const (
+	// Email and Password are the credentials of the user every Server
+	// starts with.
+	Email    = "user@example.com"
+	Password = "password"
+
+	// OrganizationID is the organization of all environments.
+	OrganizationID = 1000
+)
+
+// Server is a fake Confluent Cloud API listening on a local address.
+// It serves one request at a time: every handler runs under a single lock,
+// which keeps the in-memory state consistent without per-resource locking.
+type Server struct {
+	*httptest.Server
+
+	// ProvisioningPolls is the number of times a new cluster is reported as
+	// provisioning by GetCluster before it is up, and a new connector by
+	// GetConnectorStatus before it is running. It defaults to zero, which
+	// makes clusters and connectors available right away.
+	ProvisioningPolls int
+
+	mu               sync.Mutex
+	nextID           int
+	users            map[string]string
+	cloudAPIKeys     map[string]string
+	sessions         map[string]bool
+	defaultEnv       string
+	environments     map[string]*confluentcloud.Environment
+	clusters         map[string]*cluster
+	apiKeys          map[int]*confluentcloud.APIKey
+	serviceAccounts  map[int]*confluentcloud.ServiceAccount
+	schemaRegistries map[string]*confluentcloud.SchemaRegistry
+	connectors       map[string]map[string]*connector
+	roleBindings     map[string]*confluentcloud.RoleBinding
+	schemaStores     map[string]*schemaStore
+}
+
+type cluster struct {
+	confluentcloud.Cluster
+	pendingPolls int
+	topics       map[string]*topic
+	acls         []confluentcloud.ACLBinding
+}
+
+type connector struct {
+	confluentcloud.Connector
+	pendingPolls int
+	status       confluentcloud.ConnectorStatus
+}
+
+// NewServer starts a Server with one user, identified by Email and Password,
+// and one default environment.
+func NewServer() *Server {
+	s := &Server{
+		users:            map[string]string{Email: Password},
+		cloudAPIKeys:     make(map[string]string),
+		sessions:         make(map[string]bool),
+		environments:     make(map[string]*confluentcloud.Environment),
+		clusters:         make(map[string]*cluster),
+		apiKeys:          make(map[int]*confluentcloud.APIKey),
+		serviceAccounts:  make(map[int]*confluentcloud.ServiceAccount),
+		schemaRegistries: make(map[string]*confluentcloud.SchemaRegistry),
+		connectors:       make(map[string]map[string]*connector),
+		roleBindings:     make(map[string]*confluentcloud.RoleBinding),
+		schemaStores:     make(map[string]*schemaStore),
+	}
+
+	env := &confluentcloud.Environment{ID: s.newID("env"), Name: "default", OrganizationID: OrganizationID}
+	s.environments[env.ID] = env
+	s.defaultEnv = env.ID
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient returns a client for the server that logs in as the default
+// user.
+func (s *Server) NewClient(opts ...confluentcloud.ClientOption) *confluentcloud.Client {
+	return confluentcloud.NewClient(Email, Password, append([]confluentcloud.ClientOption{confluentcloud.WithBaseURL(s.baseURL())}, opts...)...)
+}
+
+// NewClientWithAPIKey registers a Cloud API key and returns a client for the
+// server that authenticates with it.
+func (s *Server) NewClientWithAPIKey(opts ...confluentcloud.ClientOption) *confluentcloud.Client {
+	key, secret := s.AddCloudAPIKey()
+	return confluentcloud.NewClientWithAPIKey(key, secret, append([]confluentcloud.ClientOption{confluentcloud.WithBaseURL(s.baseURL())}, opts...)...)
+}
+
+// AddUser allows email and password to log in.
+func (s *Server) AddUser(email, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[email] = password
+}
+
+// AddCloudAPIKey creates a Cloud API key and secret accepted by the server.
+func (s *Server) AddCloudAPIKey() (key, secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key, secret = strings.ToUpper(randomHex(8)), randomHex(32)
+	s.cloudAPIKeys[key] = secret
+	return key, secret
+}
+
+// ExpireSessions invalidates all session tokens, as if they had timed out.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = make(map[string]bool)
+}
+
+// DefaultEnvironmentID returns the ID of the environment the server starts
+// with, which is also the account returned by Me.
+func (s *Server) DefaultEnvironmentID() string {
+	return s.defaultEnv
+}
+
+// FailConnectorTask marks a task of a connector as failed with trace, as if
+// it had crashed. Restarting the task makes it run again.
+func (s *Server) FailConnectorTask(clusterID string, task confluentcloud.ConnectorTask, trace string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.connectors[clusterID][task.ConnectorName]
+	if !ok || task.TaskNo < 0 || task.TaskNo >= len(c.status.Tasks) {
+		return fmt.Errorf("task %d of connector %s not found", task.TaskNo, task.ConnectorName)
+	}
+	c.status.Tasks[task.TaskNo].State = confluentcloud.ConnectorStateFailed
+	c.status.Tasks[task.TaskNo].Trace = trace
+	return nil
+}
+
+func (s *Server) baseURL() *url.URL {
+	u, _ := url.Parse(s.URL)
+	return u
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if path[0] == "sessions" && len(path) == 1 && r.Method == http.MethodPost {
+		s.login(w, r)
+		return
+	}
+
+	if path[0] == "kafka" && len(path) >= 4 && path[1] == "v3" && path[2] == "clusters" {
+		s.serveKafka(w, r, path[3], path[4:])
+		return
+	}
+	if path[0] == "schema-registry" && len(path) >= 2 {
+		s.serveSchemas(w, r, path[1], path[2:])
+		return
+	}
+
+	if !s.authenticated(r) {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	switch {
+	case path[0] == "me" && len(path) == 1:
+		s.me(w, r)
+	case path[0] == "accounts" && len(path) >= 5 && path[2] == "clusters" && path[4] == "connectors":
+		s.serveConnectors(w, r, path[1], path[3], path[5:])
+	case path[0] == "accounts" && len(path) >= 5 && path[2] == "clusters" && path[4] == "connector-plugins":
+		s.serveConnectorPlugins(w, r, path[1], path[3], path[5:])
+	case path[0] == "accounts":
+		s.serveEnvironments(w, r, path[1:])
+	case path[0] == "clusters":
+		s.serveClusters(w, r, path[1:])
+	case path[0] == "api_keys":
+		s.serveAPIKeys(w, r, path[1:])
+	case path[0] == "service_accounts":
+		s.serveServiceAccounts(w, r, path[1:])
+	case path[0] == "schema_registries":
+		s.serveSchemaRegistries(w, r, path[1:])
+	case path[0] == "iam" && len(path) >= 2 && path[1] == "v2":
+		s.serveIAM(w, r, path[2:])
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	var req confluentcloud.AuthRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if password, ok := s.users[req.Email]; !ok || password != req.Password {
+		writeError(w, http.StatusUnauthorized, "invalid email or password")
+		return
+	}
+
+	token := randomHex(32)
+	s.sessions[token] = true
+	writeJSON(w, http.StatusOK, confluentcloud.AuthSuccessResponse{Token: token})
+}
+
+func (s *Server) authenticated(r *http.Request) bool {
+	if key, secret, ok := r.BasicAuth(); ok {
+		expected, found := s.cloudAPIKeys[key]
+		return found && expected == secret
+	}
+
+	if token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "); token != "" && s.sessions[token] {
+		return true
+	}
+	if cookie, err := r.Cookie("auth_token"); err == nil && s.sessions[cookie.Value] {
+		return true
+	}
+	return false
+}
+
+func (s *Server) me(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, r)
+		return
+	}
+
+	env := s.environments[s.defaultEnv]
+	writeJSON(w, http.StatusOK, confluentcloud.UserInfoRequest{
+		Account: confluentcloud.AccountMessage{
+			ID:             env.ID,
+			Name:           env.Name,
+			OrganizationID: env.OrganizationID,
+		},
+		Organization: map[string]interface{}{"id": OrganizationID},
+		User:         map[string]interface{}{"email": Email},
+	})
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%05d", prefix, s.nextID)
+}
+
+func (s *Server) newIntID() int {
+	s.nextID++
+	return 100000 + s.nextID
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n/2)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed request body: %v", err))
+		return false
+	}
+	return true
+}
+
+// page returns the bounds of the page of an n item iam/v2 list selected by
+// the page_size and page_token query parameters, and the token of the page
+// after it. The token is simply the offset of the page's first item.
+func page(w http.ResponseWriter, r *http.Request, n int) (start, end int, next string, ok bool) {
+	query := r.URL.Query()
+	end = n
+	if token := query.Get("page_token"); token != "" {
+		var err error
+		start, err = strconv.Atoi(token)
+		if err != nil || start < 0 || start > n {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid page_token %q", token))
+			return 0, 0, "", false
+		}
+	}
+	if size := query.Get("page_size"); size != "" {
+		limit, err := strconv.Atoi(size)
+		if err != nil || limit <= 0 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid page_size %q", size))
+			return 0, 0, "", false
+		}
+		if start+limit < n {
+			end = start + limit
+			next = strconv.Itoa(end)
+		}
+	}
+	return start, end, next, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the shape used by most endpoints.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, confluentcloud.ErrorResponse{
+		Error: confluentcloud.ErrorMessage{Code: status, Message: message},
+	})
+}
+
+// writeValidationError writes a 400 error listing the invalid fields.
+func writeValidationError(w http.ResponseWriter, fields map[string]string) {
+	writeJSON(w, http.StatusBadRequest, confluentcloud.ErrorResponse{
+		Error: confluentcloud.ErrorMessage{
+			Code:         http.StatusBadRequest,
+			Message:      "validation failed",
+			NestedErrors: fields,
+		},
+	})
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed on %s", r.Method, r.URL.Path))
+}
@@ -0,0 +1,355 @@
+package confluentcloudtest_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/confluentcloudtest"
+)
+
+// newCluster creates a cluster in the default environment of srv.
+func newCluster(t *testing.T, srv *confluentcloudtest.Server, c *confluentcloud.Client) *confluentcloud.Cluster {
+	t.Helper()
+	cluster, err := c.CreateCluster(confluentcloud.ClusterCreateConfig{
+		Name:            "orders",
+		AccountID:       srv.DefaultEnvironmentID(),
+		Region:          "us-west-2",
+		ServiceProvider: "aws",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cluster
+}
+
+func TestLoginAndMe(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+
+	c := srv.NewClient()
+	if err := c.Login(); err != nil {
+		t.Fatal(err)
+	}
+
+	me, err := c.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if me.Account.ID != srv.DefaultEnvironmentID() {
+		t.Errorf("got account %s, want %s", me.Account.ID, srv.DefaultEnvironmentID())
+	}
+
+	srv.ExpireSessions()
+	if _, err := c.Me(); err != nil {
+		t.Errorf("got %v after the session expired, want the client to log in again", err)
+	}
+}
+
+func TestWrongPassword(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+
+	c := confluentcloud.NewClient(confluentcloudtest.Email, "wrong", confluentcloud.WithBaseURL(srv.NewClient().BaseURL))
+	if err := c.Login(); !confluentcloud.IsUnauthorized(err) {
+		t.Fatalf("got %v, want an unauthorized error", err)
+	}
+
+	srv.AddUser("other@example.com", "secret")
+	c = confluentcloud.NewClient("other@example.com", "secret", confluentcloud.WithBaseURL(srv.NewClient().BaseURL))
+	if err := c.Login(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEnvironments(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+
+	env, err := c.CreateEnvironment("staging", confluentcloudtest.OrganizationID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateEnvironment("staging", confluentcloudtest.OrganizationID); !confluentcloud.IsConflict(err) {
+		t.Errorf("got %v for a duplicate name, want a conflict", err)
+	}
+
+	if _, err := c.UpdateEnvironment(env.ID, "qa", confluentcloudtest.OrganizationID); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetEnvironment(env.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "qa" {
+		t.Errorf("got name %q, want qa", got.Name)
+	}
+
+	envs, err := c.ListEnvironments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(envs) != 2 {
+		t.Errorf("got %d environments, want 2", len(envs))
+	}
+
+	if err := c.DeleteEnvironment(env.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetEnvironment(env.ID); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v after delete, want not found", err)
+	}
+}
+
+func TestClusters(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	srv.ProvisioningPolls = 2
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+
+	cluster := newCluster(t, srv, c)
+	if cluster.Status != confluentcloud.ClusterStatusProvisioning {
+		t.Errorf("got status %s, want %s", cluster.Status, confluentcloud.ClusterStatusProvisioning)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	up, err := c.WaitForClusterContext(ctx, cluster.ID, env, confluentcloud.ClusterStatusUp, &confluentcloud.WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if up.APIEndpoint == "" {
+		t.Error("got no API endpoint for a cluster that is up")
+	}
+
+	if err := c.UpdateCluster(cluster.ID, env, "payments"); err != nil {
+		t.Fatal(err)
+	}
+	clusters, err := c.ListClusters(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clusters) != 1 || clusters[0].Name != "payments" {
+		t.Errorf("got clusters %+v, want one named payments", clusters)
+	}
+
+	if err := c.DeleteEnvironment(env); !confluentcloud.IsConflict(err) {
+		t.Errorf("got %v deleting an environment with clusters, want a conflict", err)
+	}
+	if err := c.DeleteCluster(cluster.ID, env); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetCluster(cluster.ID, env); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v after delete, want not found", err)
+	}
+}
+
+func TestAPIKeysAndServiceAccounts(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+	cluster := newCluster(t, srv, c)
+
+	sa, err := c.CreateServiceAccount(&confluentcloud.ServiceAccountCreateRequest{Name: "ci", Description: "deploys"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateServiceAccount(&confluentcloud.ServiceAccountCreateRequest{Name: "ci"}); !confluentcloud.IsConflict(err) {
+		t.Errorf("got %v for a duplicate service account, want a conflict", err)
+	}
+
+	key, err := c.CreateAPIKey(&confluentcloud.ApiKeyCreateRequest{
+		AccountID:       env,
+		UserID:          sa.ID,
+		LogicalClusters: []confluentcloud.LogicalCluster{{ID: cluster.ID}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Secret == "" || !key.ServiceAccount {
+		t.Errorf("got key %+v, want a service account key with a secret", key)
+	}
+
+	keys, err := c.ListAPIKeys(cluster.ID, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0].Key != key.Key || keys[0].Secret != "" {
+		t.Errorf("got keys %+v, want the created key without its secret", keys)
+	}
+
+	if _, err := c.CreateAPIKey(&confluentcloud.ApiKeyCreateRequest{AccountID: env}); err == nil {
+		t.Error("got no error for a key without logical clusters")
+	}
+
+	if err := c.DeleteAPIKey(strconv.Itoa(key.ID), env, key.LogicalClusters); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteServiceAccount(sa.ID); err != nil {
+		t.Fatal(err)
+	}
+	accounts, err := c.ListServiceAccounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 0 {
+		t.Errorf("got service accounts %+v after delete, want none", accounts)
+	}
+}
+
+func TestSchemaRegistry(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+
+	if _, err := c.CreateSchemaRegistry(env, "us", "aws"); err == nil {
+		t.Error("got no error enabling a registry without clusters")
+	}
+	if _, err := c.GetSchemaRegistry(env); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v without a registry, want not found", err)
+	}
+
+	newCluster(t, srv, c)
+	sr, err := c.CreateSchemaRegistry(env, "US", "AWS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := c.CreateSchemaRegistry(env, "us", "aws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != sr.ID {
+		t.Errorf("got registry %s the second time, want %s", again.ID, sr.ID)
+	}
+
+	got, err := c.GetSchemaRegistry(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != sr.ID || got.Endpoint == "" {
+		t.Errorf("got registry %+v, want %s with an endpoint", got, sr.ID)
+	}
+}
+
+func TestConnectors(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+	cluster := newCluster(t, srv, c)
+
+	config := confluentcloud.ConnectorConfig{"connector.class": "DatagenSource", "kafka.topic": "orders"}
+	info, err := c.CreateConnector(env, cluster.ID, "datagen", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Type != "source" || info.Config["name"] != "datagen" || len(info.Tasks) != 1 {
+		t.Errorf("got connector %+v", info)
+	}
+	if _, err := c.CreateConnector(env, cluster.ID, "datagen", config); !confluentcloud.IsConflict(err) {
+		t.Errorf("got %v for a duplicate connector, want a conflict", err)
+	}
+	if _, err := c.CreateConnector(env, cluster.ID, "broken", confluentcloud.ConnectorConfig{}); err == nil {
+		t.Error("got no error for a connector without a class")
+	}
+
+	config["kafka.topic"] = "payments"
+	if _, err := c.UpdateConnectorConfig(env, cluster.ID, "datagen", config); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetConnector(env, cluster.ID, "datagen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Config["kafka.topic"] != "payments" {
+		t.Errorf("got config %v, want the updated topic", got.Config)
+	}
+
+	connectors, err := c.ListConnectors(env, cluster.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(connectors) != 1 || connectors[0].ID.ID == "" {
+		t.Errorf("got connectors %+v, want one with an ID", connectors)
+	}
+
+	if err := c.DeleteConnector(env, cluster.ID, "datagen"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetConnector(env, cluster.ID, "datagen"); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v after delete, want not found", err)
+	}
+}
+
+func TestRoleBindingPages(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+
+	scope := confluentcloud.OrganizationScope(confluentcloudtest.OrganizationID)
+	for _, principal := range []string{"User:1", "User:2", "User:3"} {
+		if _, err := c.CreateRoleBinding(principal, "OrganizationAdmin", scope); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	it := c.IterateRoleBindings(context.Background(), confluentcloud.RoleBindingFilter{}, &confluentcloud.ListOptions{PageSize: 2})
+	for it.Next() {
+		got = append(got, it.RoleBinding().Principal)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Errorf("got principals %v over two pages, want 3", got)
+	}
+}
+
+func TestIterateV1Lists(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+	ctx := context.Background()
+
+	for _, name := range []string{"staging", "qa"} {
+		if _, err := c.CreateEnvironment(name, confluentcloudtest.OrganizationID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var environments []string
+	envs := c.IterateEnvironments(ctx)
+	for envs.Next() {
+		environments = append(environments, envs.Environment().Name)
+	}
+	if err := envs.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(environments) != 3 {
+		t.Errorf("got environments %v, want 3", environments)
+	}
+
+	cluster := newCluster(t, srv, c)
+	clusters := c.IterateClusters(ctx, env)
+	if !clusters.Next() || clusters.Cluster().ID != cluster.ID {
+		t.Errorf("got cluster %+v, want %s", clusters.Cluster(), cluster.ID)
+	}
+	if clusters.Next() {
+		t.Errorf("got a second cluster %+v", clusters.Cluster())
+	}
+
+	connectors := c.IterateConnectors(ctx, env, "lkc-missing")
+	if connectors.Next() {
+		t.Errorf("got connector %+v of a missing cluster", connectors.Connector())
+	}
+	if !confluentcloud.IsNotFound(connectors.Err()) {
+		t.Errorf("got %v for a missing cluster, want not found", connectors.Err())
+	}
+}
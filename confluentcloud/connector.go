@@ -1,8 +1,12 @@
 package confluentcloud
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 type ConnectorConfig = map[string]string
@@ -29,6 +33,104 @@ type Connector struct {
 	Info ConnectorInfo `json:"info"`
 }
 
+// ConnectorState is the runtime state of a connector or of one of its tasks.
+type ConnectorState string
+
+const (
+	ConnectorStateProvisioning ConnectorState = "PROVISIONING"
+	ConnectorStateRunning      ConnectorState = "RUNNING"
+	ConnectorStatePaused       ConnectorState = "PAUSED"
+	ConnectorStateFailed       ConnectorState = "FAILED"
+	ConnectorStateUnassigned   ConnectorState = "UNASSIGNED"
+)
+
+// ConnectorStateInfo is the state of a connector. Trace holds the stack
+// trace of the error of a FAILED connector.
+type ConnectorStateInfo struct {
+	State    ConnectorState `json:"state"`
+	WorkerID string         `json:"worker_id"`
+	Trace    string         `json:"trace,omitempty"`
+}
+
+// ConnectorTaskStatus is the state of one task of a connector.
+type ConnectorTaskStatus struct {
+	ID       int            `json:"id"`
+	State    ConnectorState `json:"state"`
+	WorkerID string         `json:"worker_id"`
+	Trace    string         `json:"trace,omitempty"`
+}
+
+// ConnectorStatus is the runtime state of a connector and its tasks.
+type ConnectorStatus struct {
+	Name      string                `json:"name"`
+	Type      string                `json:"type"`
+	Connector ConnectorStateInfo    `json:"connector"`
+	Tasks     []ConnectorTaskStatus `json:"tasks"`
+}
+
+// Running reports whether the connector and all of its tasks are running.
+// A connector is not considered running before its tasks have started.
+func (s *ConnectorStatus) Running() bool {
+	if s.Connector.State != ConnectorStateRunning || len(s.Tasks) == 0 {
+		return false
+	}
+	for _, task := range s.Tasks {
+		if task.State != ConnectorStateRunning {
+			return false
+		}
+	}
+	return true
+}
+
+// failure returns a *ConnectorFailedError for the connector or the first of
+// its tasks that failed, or nil.
+func (s *ConnectorStatus) failure() error {
+	if s.Connector.State == ConnectorStateFailed {
+		return &ConnectorFailedError{Name: s.Name, Trace: s.Connector.Trace}
+	}
+	for _, task := range s.Tasks {
+		if task.State == ConnectorStateFailed {
+			return &ConnectorFailedError{
+				Name:  s.Name,
+				Task:  &ConnectorTask{ConnectorName: s.Name, TaskNo: task.ID},
+				Trace: task.Trace,
+			}
+		}
+	}
+	return nil
+}
+
+// ConnectorFailedError is returned by WaitForConnectorRunning when the
+// connector or one of its tasks failed. Task is nil if the connector itself
+// failed.
+type ConnectorFailedError struct {
+	Name  string
+	Task  *ConnectorTask
+	Trace string
+}
+
+func (e *ConnectorFailedError) Error() string {
+	msg := fmt.Sprintf("connector %s failed", e.Name)
+	if e.Task != nil {
+		msg = fmt.Sprintf("task %d of connector %s failed", e.Task.TaskNo, e.Name)
+	}
+	if e.Trace != "" {
+		// The first line of the trace holds the exception and its message.
+		msg += ": " + strings.SplitN(e.Trace, "\n", 2)[0]
+	}
+	return msg
+}
+
+// ConnectorPausedError is returned by WaitForConnectorRunning when the
+// connector is paused, which it stays until ResumeConnector.
+type ConnectorPausedError struct {
+	Name string
+}
+
+func (e *ConnectorPausedError) Error() string {
+	return fmt.Sprintf("connector %s is paused", e.Name)
+}
+
 type CreateConnectorRequest struct {
 	Name   string          `json:"name"`
 	Config ConnectorConfig `json:"config"`
@@ -37,6 +139,10 @@ type CreateConnectorRequest struct {
 type ListConnectorsResponse = map[string]Connector
 
 func (c *Client) ListConnectors(account_id, cluster_id string) ([]Connector, error) {
+	return c.ListConnectorsContext(context.Background(), account_id, cluster_id)
+}
+
+func (c *Client) ListConnectorsContext(ctx context.Context, account_id, cluster_id string) ([]Connector, error) {
 	rel, err := url.Parse(fmt.Sprintf("accounts/%s/clusters/%s/connectors", account_id, cluster_id))
 	if err != nil {
 		return []Connector{}, err
@@ -44,9 +150,9 @@ func (c *Client) ListConnectors(account_id, cluster_id string) ([]Connector, err
 
 	u := c.BaseURL.ResolveReference(rel)
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetQueryParam("expand", "id,info").
 		SetResult(&ListConnectorsResponse{}).
-		SetError(&ErrorResponse{}).
 		Get(u.String())
 
 	if err != nil {
@@ -54,7 +160,7 @@ func (c *Client) ListConnectors(account_id, cluster_id string) ([]Connector, err
 	}
 
 	if response.IsError() {
-		return []Connector{}, fmt.Errorf("connectors: %s", response.Error().(*ErrorResponse).Error.Message)
+		return []Connector{}, newAPIError("connectors", response)
 	}
 
 	result := response.Result().(*ListConnectorsResponse)
@@ -67,7 +173,47 @@ func (c *Client) ListConnectors(account_id, cluster_id string) ([]Connector, err
 	return list, nil
 }
 
+// ConnectorIterator iterates over the connectors of a cluster. The v1 API returns the
+// complete list, which is requested by the first call to Next.
+type ConnectorIterator struct {
+	pager
+	page    []Connector
+	current Connector
+}
+
+// Next advances to the next connector. It returns false at the end of the
+// list or when the request failed; check Err to tell them apart.
+func (it *ConnectorIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Connector returns the connector Next advanced to.
+func (it *ConnectorIterator) Connector() Connector {
+	return it.current
+}
+
+// IterateConnectors returns an iterator over the connectors of a cluster.
+func (c *Client) IterateConnectors(ctx context.Context, accountID, clusterID string) *ConnectorIterator {
+	it := &ConnectorIterator{}
+	it.pager = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		var err error
+		it.page, err = c.ListConnectorsContext(ctx, accountID, clusterID)
+		return "", err
+	}}
+	return it
+}
+
 func (c *Client) CreateConnector(account_id, cluster_id, name string, config ConnectorConfig) (*ConnectorInfo, error) {
+	return c.CreateConnectorContext(context.Background(), account_id, cluster_id, name, config)
+}
+
+func (c *Client) CreateConnectorContext(ctx context.Context, account_id, cluster_id, name string, config ConnectorConfig) (*ConnectorInfo, error) {
 	rel, err := url.Parse(fmt.Sprintf("accounts/%s/clusters/%s/connectors", account_id, cluster_id))
 	if err != nil {
 		return nil, err
@@ -76,9 +222,9 @@ func (c *Client) CreateConnector(account_id, cluster_id, name string, config Con
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(&CreateConnectorRequest{Name: name, Config: config}).
 		SetResult(&ConnectorInfo{}).
-		SetError(&ErrorMessage{}).
 		Post(u.String())
 
 	if err != nil {
@@ -86,13 +232,17 @@ func (c *Client) CreateConnector(account_id, cluster_id, name string, config Con
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("connectors: %s", response.Error().(*ErrorMessage).Message)
+		return nil, newAPIError("connectors", response)
 	}
 
 	return response.Result().(*ConnectorInfo), nil
 }
 
 func (c *Client) UpdateConnectorConfig(account_id, cluster_id, name string, config ConnectorConfig) (*ConnectorInfo, error) {
+	return c.UpdateConnectorConfigContext(context.Background(), account_id, cluster_id, name, config)
+}
+
+func (c *Client) UpdateConnectorConfigContext(ctx context.Context, account_id, cluster_id, name string, config ConnectorConfig) (*ConnectorInfo, error) {
 	rel, err := url.Parse(fmt.Sprintf("accounts/%s/clusters/%s/connectors/%s/config", account_id, cluster_id, name))
 	if err != nil {
 		return nil, err
@@ -101,9 +251,9 @@ func (c *Client) UpdateConnectorConfig(account_id, cluster_id, name string, conf
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(&config).
 		SetResult(&ConnectorInfo{}).
-		SetError(&ErrorResponse{}).
 		Put(u.String())
 
 	if err != nil {
@@ -111,7 +261,7 @@ func (c *Client) UpdateConnectorConfig(account_id, cluster_id, name string, conf
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("connectors: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("connectors", response)
 	}
 
 	return response.Result().(*ConnectorInfo), nil
@@ -119,6 +269,10 @@ func (c *Client) UpdateConnectorConfig(account_id, cluster_id, name string, conf
 }
 
 func (c *Client) GetConnector(account_id, cluster_id, name string) (*ConnectorInfo, error) {
+	return c.GetConnectorContext(context.Background(), account_id, cluster_id, name)
+}
+
+func (c *Client) GetConnectorContext(ctx context.Context, account_id, cluster_id, name string) (*ConnectorInfo, error) {
 	rel, err := url.Parse(fmt.Sprintf("accounts/%s/clusters/%s/connectors/%s", account_id, cluster_id, name))
 	if err != nil {
 		return nil, err
@@ -127,8 +281,8 @@ func (c *Client) GetConnector(account_id, cluster_id, name string) (*ConnectorIn
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetResult(&ConnectorInfo{}).
-		SetError(&ErrorResponse{}).
 		Get(u.String())
 
 	if err != nil {
@@ -136,13 +290,17 @@ func (c *Client) GetConnector(account_id, cluster_id, name string) (*ConnectorIn
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("connectors: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("connectors", response)
 	}
 
 	return response.Result().(*ConnectorInfo), nil
 }
 
 func (c *Client) DeleteConnector(account_id, cluster_id, name string) error {
+	return c.DeleteConnectorContext(context.Background(), account_id, cluster_id, name)
+}
+
+func (c *Client) DeleteConnectorContext(ctx context.Context, account_id, cluster_id, name string) error {
 	rel, err := url.Parse(fmt.Sprintf("accounts/%s/clusters/%s/connectors/%s", account_id, cluster_id, name))
 	if err != nil {
 		return err
@@ -151,7 +309,7 @@ func (c *Client) DeleteConnector(account_id, cluster_id, name string) error {
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
-		SetError(&ErrorResponse{}).
+		SetContext(ctx).
 		Delete(u.String())
 
 	if err != nil {
@@ -159,7 +317,97 @@ func (c *Client) DeleteConnector(account_id, cluster_id, name string) error {
 	}
 
 	if response.IsError() {
-		return fmt.Errorf("connectors: %s", response.Error().(*ErrorResponse).Error.Message)
+		return newAPIError("connectors", response)
+	}
+
+	return nil
+}
+
+// GetConnectorStatus returns the runtime state of a connector and its tasks.
+func (c *Client) GetConnectorStatus(accountID, clusterID, name string) (*ConnectorStatus, error) {
+	return c.GetConnectorStatusContext(context.Background(), accountID, clusterID, name)
+}
+
+func (c *Client) GetConnectorStatusContext(ctx context.Context, accountID, clusterID, name string) (*ConnectorStatus, error) {
+	u, err := resolve(c.BaseURL, "accounts/%s/clusters/%s/connectors/%s/status", accountID, clusterID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetResult(&ConnectorStatus{}).
+		Get(u)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, newAPIError("get connector status", response)
+	}
+
+	return response.Result().(*ConnectorStatus), nil
+}
+
+// PauseConnector pauses a connector and its tasks. The connector keeps its
+// offsets and continues where it left off when resumed.
+func (c *Client) PauseConnector(accountID, clusterID, name string) error {
+	return c.PauseConnectorContext(context.Background(), accountID, clusterID, name)
+}
+
+func (c *Client) PauseConnectorContext(ctx context.Context, accountID, clusterID, name string) error {
+	return c.connectorAction(ctx, "pause connector", http.MethodPut, "accounts/%s/clusters/%s/connectors/%s/pause", accountID, clusterID, name)
+}
+
+// ResumeConnector resumes a paused connector and its tasks.
+func (c *Client) ResumeConnector(accountID, clusterID, name string) error {
+	return c.ResumeConnectorContext(context.Background(), accountID, clusterID, name)
+}
+
+func (c *Client) ResumeConnectorContext(ctx context.Context, accountID, clusterID, name string) error {
+	return c.connectorAction(ctx, "resume connector", http.MethodPut, "accounts/%s/clusters/%s/connectors/%s/resume", accountID, clusterID, name)
+}
+
+// RestartConnector restarts a connector. Its tasks are not restarted; use
+// RestartTask for those.
+func (c *Client) RestartConnector(accountID, clusterID, name string) error {
+	return c.RestartConnectorContext(context.Background(), accountID, clusterID, name)
+}
+
+func (c *Client) RestartConnectorContext(ctx context.Context, accountID, clusterID, name string) error {
+	return c.connectorAction(ctx, "restart connector", http.MethodPost, "accounts/%s/clusters/%s/connectors/%s/restart", accountID, clusterID, name)
+}
+
+// RestartTask restarts one task of a connector, e.g. one listed in the
+// Tasks of its ConnectorInfo.
+func (c *Client) RestartTask(accountID, clusterID string, task ConnectorTask) error {
+	return c.RestartTaskContext(context.Background(), accountID, clusterID, task)
+}
+
+func (c *Client) RestartTaskContext(ctx context.Context, accountID, clusterID string, task ConnectorTask) error {
+	return c.connectorAction(ctx, "restart task", http.MethodPost, "accounts/%s/clusters/%s/connectors/%s/tasks/%s/restart",
+		accountID, clusterID, task.ConnectorName, strconv.Itoa(task.TaskNo))
+}
+
+// connectorAction sends a request without a body to a connector endpoint
+// that responds without one.
+func (c *Client) connectorAction(ctx context.Context, op, method, format string, args ...string) error {
+	u, err := resolve(c.BaseURL, format, args...)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		Execute(method, u)
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError(op, response)
 	}
 
 	return nil
@@ -0,0 +1,163 @@
+package confluentcloud
+
+import (
+	"context"
+	"sort"
+)
+
+// ConnectorPlugin is a connector type that can be created in a cluster.
+type ConnectorPlugin struct {
+	Class   string `json:"class"`
+	Type    string `json:"type"`
+	Version string `json:"version,omitempty"`
+}
+
+// ConnectorConfigDefinition describes a configuration property of a
+// connector plugin.
+type ConnectorConfigDefinition struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Required      bool     `json:"required"`
+	DefaultValue  string   `json:"default_value"`
+	Importance    string   `json:"importance"`
+	Documentation string   `json:"documentation"`
+	Group         string   `json:"group"`
+	DisplayName   string   `json:"display_name"`
+	Dependents    []string `json:"dependents"`
+	Order         int      `json:"order"`
+}
+
+// ConnectorConfigValue is the outcome of validating one configuration
+// property. RecommendedValues lists the accepted values of properties with a
+// fixed set of choices.
+type ConnectorConfigValue struct {
+	Name              string   `json:"name"`
+	Value             string   `json:"value"`
+	RecommendedValues []string `json:"recommended_values"`
+	Errors            []string `json:"errors"`
+	Visible           bool     `json:"visible"`
+}
+
+// ConnectorConfigInfo pairs the definition of a property with its validated
+// value.
+type ConnectorConfigInfo struct {
+	Definition ConnectorConfigDefinition `json:"definition"`
+	Value      ConnectorConfigValue      `json:"value"`
+}
+
+// ConnectorConfigValidation is the result of validating a connector
+// configuration against its plugin.
+type ConnectorConfigValidation struct {
+	Name       string                `json:"name"`
+	ErrorCount int                   `json:"error_count"`
+	Groups     []string              `json:"groups"`
+	Configs    []ConnectorConfigInfo `json:"configs"`
+}
+
+// Valid reports whether the configuration has no errors.
+func (v *ConnectorConfigValidation) Valid() bool {
+	return v.ErrorCount == 0
+}
+
+// Errors returns the errors of the configuration by property name. Valid
+// properties are left out.
+func (v *ConnectorConfigValidation) Errors() map[string][]string {
+	errors := make(map[string][]string)
+	for _, config := range v.Configs {
+		if len(config.Value.Errors) > 0 {
+			errors[config.Definition.Name] = config.Value.Errors
+		}
+	}
+	return errors
+}
+
+// Required returns the sorted names of the properties the plugin requires.
+func (v *ConnectorConfigValidation) Required() []string {
+	required := []string{}
+	for _, config := range v.Configs {
+		if config.Definition.Required {
+			required = append(required, config.Definition.Name)
+		}
+	}
+	sort.Strings(required)
+	return required
+}
+
+// RecommendedValues returns the recommended values of the properties that
+// have any, by property name.
+func (v *ConnectorConfigValidation) RecommendedValues() map[string][]string {
+	recommended := make(map[string][]string)
+	for _, config := range v.Configs {
+		if len(config.Value.RecommendedValues) > 0 {
+			recommended[config.Definition.Name] = config.Value.RecommendedValues
+		}
+	}
+	return recommended
+}
+
+// ListConnectorPlugins returns the connector plugins available in a cluster.
+func (c *Client) ListConnectorPlugins(accountID, clusterID string) ([]ConnectorPlugin, error) {
+	return c.ListConnectorPluginsContext(context.Background(), accountID, clusterID)
+}
+
+func (c *Client) ListConnectorPluginsContext(ctx context.Context, accountID, clusterID string) ([]ConnectorPlugin, error) {
+	u, err := resolve(c.BaseURL, "accounts/%s/clusters/%s/connector-plugins", accountID, clusterID)
+	if err != nil {
+		return []ConnectorPlugin{}, err
+	}
+
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetResult(&[]ConnectorPlugin{}).
+		Get(u)
+
+	if err != nil {
+		return []ConnectorPlugin{}, err
+	}
+
+	if response.IsError() {
+		return []ConnectorPlugin{}, newAPIError("list connector plugins", response)
+	}
+
+	return *response.Result().(*[]ConnectorPlugin), nil
+}
+
+// ValidateConnectorConfig validates config against plugin, the Class of a
+// ConnectorPlugin, without creating a connector. An invalid config is not an
+// error: check Valid and Errors of the returned validation.
+func (c *Client) ValidateConnectorConfig(accountID, clusterID, plugin string, config ConnectorConfig) (*ConnectorConfigValidation, error) {
+	return c.ValidateConnectorConfigContext(context.Background(), accountID, clusterID, plugin, config)
+}
+
+func (c *Client) ValidateConnectorConfigContext(ctx context.Context, accountID, clusterID, plugin string, config ConnectorConfig) (*ConnectorConfigValidation, error) {
+	u, err := resolve(c.BaseURL, "accounts/%s/clusters/%s/connector-plugins/%s/config/validate", accountID, clusterID, plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := config["connector.class"]; !ok {
+		// The endpoint requires the class in the config as well.
+		withClass := make(ConnectorConfig, len(config)+1)
+		for k, v := range config {
+			withClass[k] = v
+		}
+		withClass["connector.class"] = plugin
+		config = withClass
+	}
+
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetBody(&config).
+		SetResult(&ConnectorConfigValidation{}).
+		Put(u)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, newAPIError("validate connector config", response)
+	}
+
+	return response.Result().(*ConnectorConfigValidation), nil
+}
@@ -0,0 +1,106 @@
+package confluentcloud_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/confluentcloudtest"
+)
+
+func TestListConnectorPlugins(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+	cluster, err := c.CreateCluster(confluentcloud.ClusterCreateConfig{Name: "orders", AccountID: env, Region: "us-west-2", ServiceProvider: "aws"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plugins, err := c.ListConnectorPlugins(env, cluster.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	classes := make([]string, len(plugins))
+	for i, p := range plugins {
+		classes[i] = p.Class
+	}
+	if want := []string{"DatagenSource", "S3_SINK"}; !reflect.DeepEqual(classes, want) {
+		t.Errorf("got plugins %v, want %v", classes, want)
+	}
+
+	if _, err := c.ListConnectorPlugins(env, "lkc-missing"); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v for an unknown cluster, want not found", err)
+	}
+}
+
+func TestValidateConnectorConfig(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+	cluster, err := c.CreateCluster(confluentcloud.ClusterCreateConfig{Name: "orders", AccountID: env, Region: "us-west-2", ServiceProvider: "aws"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		config     confluentcloud.ConnectorConfig
+		wantErrors []string
+	}{
+		{"valid", confluentcloud.ConnectorConfig{
+			"name":               "datagen",
+			"kafka.topic":        "orders",
+			"output.data.format": "JSON",
+			"quickstart":         "ORDERS",
+		}, nil},
+		{"missing properties", confluentcloud.ConnectorConfig{
+			"kafka.topic": "orders",
+		}, []string{"name", "output.data.format", "quickstart"}},
+		{"invalid values", confluentcloud.ConnectorConfig{
+			"name":               "datagen",
+			"kafka.topic":        "orders",
+			"output.data.format": "XML",
+			"quickstart":         "ORDERS",
+			"tasks.max":          "many",
+		}, []string{"output.data.format", "tasks.max"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validation, err := c.ValidateConnectorConfig(env, cluster.ID, "DatagenSource", tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if validation.Valid() != (len(tt.wantErrors) == 0) {
+				t.Errorf("got Valid %v with %d errors", validation.Valid(), validation.ErrorCount)
+			}
+
+			errors := validation.Errors()
+			if len(errors) != len(tt.wantErrors) {
+				t.Errorf("got errors %v, want errors for %v", errors, tt.wantErrors)
+			}
+			for _, name := range tt.wantErrors {
+				if len(errors[name]) == 0 {
+					t.Errorf("got no error for %s", name)
+				}
+			}
+
+			want := []string{"connector.class", "kafka.topic", "name", "output.data.format", "quickstart"}
+			if got := validation.Required(); !reflect.DeepEqual(got, want) {
+				t.Errorf("got required %v, want %v", got, want)
+			}
+
+			recommended := validation.RecommendedValues()
+			if len(recommended) != 2 || !reflect.DeepEqual(recommended["quickstart"], []string{"CLICKSTREAM", "ORDERS", "PAGEVIEWS", "USERS"}) {
+				t.Errorf("got recommended values %v, want output.data.format and quickstart", recommended)
+			}
+		})
+	}
+
+	if _, err := c.ValidateConnectorConfig(env, cluster.ID, "FtpSource", confluentcloud.ConnectorConfig{}); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v for an unknown plugin, want not found", err)
+	}
+}
@@ -0,0 +1,221 @@
+package confluentcloud_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/confluentcloudtest"
+)
+
+var fastPoll = &confluentcloud.WaitOptions{Interval: time.Millisecond, Multiplier: 1}
+
+func TestConnectorStatusRunning(t *testing.T) {
+	running := confluentcloud.ConnectorStateInfo{State: confluentcloud.ConnectorStateRunning}
+	task := func(state confluentcloud.ConnectorState) confluentcloud.ConnectorTaskStatus {
+		return confluentcloud.ConnectorTaskStatus{State: state}
+	}
+
+	tests := []struct {
+		name   string
+		status confluentcloud.ConnectorStatus
+		want   bool
+	}{
+		{"all running", confluentcloud.ConnectorStatus{Connector: running, Tasks: []confluentcloud.ConnectorTaskStatus{task(confluentcloud.ConnectorStateRunning)}}, true},
+		{"no tasks yet", confluentcloud.ConnectorStatus{Connector: running}, false},
+		{"task unassigned", confluentcloud.ConnectorStatus{Connector: running, Tasks: []confluentcloud.ConnectorTaskStatus{task(confluentcloud.ConnectorStateRunning), task(confluentcloud.ConnectorStateUnassigned)}}, false},
+		{"connector paused", confluentcloud.ConnectorStatus{
+			Connector: confluentcloud.ConnectorStateInfo{State: confluentcloud.ConnectorStatePaused},
+			Tasks:     []confluentcloud.ConnectorTaskStatus{task(confluentcloud.ConnectorStateRunning)},
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.Running(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// statusServer answers connector status requests with the given statuses in
+// turn; a nil status is answered with 404.
+func statusServer(statuses ...*confluentcloud.ConnectorStatus) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if status == nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_code":404,"message":"Connector datagen not found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(status)
+	}))
+}
+
+func TestWaitForConnectorRunning(t *testing.T) {
+	running := &confluentcloud.ConnectorStatus{
+		Name:      "datagen",
+		Connector: confluentcloud.ConnectorStateInfo{State: confluentcloud.ConnectorStateRunning},
+		Tasks:     []confluentcloud.ConnectorTaskStatus{{ID: 0, State: confluentcloud.ConnectorStateRunning}},
+	}
+	connectorFailed := &confluentcloud.ConnectorStatus{
+		Name:      "datagen",
+		Connector: confluentcloud.ConnectorStateInfo{State: confluentcloud.ConnectorStateFailed, Trace: "java.lang.IllegalStateException: boom\n\tat Foo"},
+	}
+	taskFailed := &confluentcloud.ConnectorStatus{
+		Name:      "datagen",
+		Connector: confluentcloud.ConnectorStateInfo{State: confluentcloud.ConnectorStateRunning},
+		Tasks: []confluentcloud.ConnectorTaskStatus{
+			{ID: 0, State: confluentcloud.ConnectorStateRunning},
+			{ID: 1, State: confluentcloud.ConnectorStateFailed, Trace: "org.apache.kafka.connect.errors.ConnectException: denied"},
+		},
+	}
+
+	paused := &confluentcloud.ConnectorStatus{
+		Name:      "datagen",
+		Connector: confluentcloud.ConnectorStateInfo{State: confluentcloud.ConnectorStatePaused},
+		Tasks:     []confluentcloud.ConnectorTaskStatus{{ID: 0, State: confluentcloud.ConnectorStatePaused}},
+	}
+
+	tests := []struct {
+		name     string
+		statuses []*confluentcloud.ConnectorStatus
+		grace    time.Duration
+		// wantMsg is the message of the expected error, if any. wantTask
+		// is the failed task of a *ConnectorFailedError, or -1 if the
+		// connector failed.
+		wantMsg  string
+		wantTask int
+	}{
+		{"not found until created", []*confluentcloud.ConnectorStatus{nil, nil, running}, 0, "", 0},
+		{"never found", []*confluentcloud.ConnectorStatus{nil}, 20 * time.Millisecond, "get connector status: Connector datagen not found", 0},
+		{"connector failed", []*confluentcloud.ConnectorStatus{connectorFailed}, 0, "connector datagen failed: java.lang.IllegalStateException: boom", -1},
+		{"task failed", []*confluentcloud.ConnectorStatus{nil, taskFailed}, 0, "task 1 of connector datagen failed: org.apache.kafka.connect.errors.ConnectException: denied", 1},
+		{"paused", []*confluentcloud.ConnectorStatus{nil, paused}, 0, "connector datagen is paused", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := statusServer(tt.statuses...)
+			defer srv.Close()
+			u, _ := url.Parse(srv.URL)
+			c := confluentcloud.NewClientWithAPIKey("key", "secret", confluentcloud.WithBaseURL(u))
+
+			opts := *fastPoll
+			opts.NotFoundGrace = tt.grace
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			status, err := c.WaitForConnectorRunningContext(ctx, "env-1", "lkc-1", "datagen", &opts)
+			if tt.wantMsg == "" {
+				if err != nil || !status.Running() {
+					t.Fatalf("got %+v, %v, want a running connector", status, err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantMsg {
+				t.Fatalf("got %v, want %q", err, tt.wantMsg)
+			}
+
+			switch err := err.(type) {
+			case *confluentcloud.ConnectorFailedError:
+				if tt.wantTask == -1 && err.Task != nil {
+					t.Errorf("got task %+v, want the connector to fail", err.Task)
+				}
+				if tt.wantTask >= 0 && (err.Task == nil || err.Task.TaskNo != tt.wantTask) {
+					t.Errorf("got task %+v, want task %d", err.Task, tt.wantTask)
+				}
+			case *confluentcloud.ConnectorPausedError:
+			default:
+				if !confluentcloud.IsNotFound(err) {
+					t.Errorf("got %T, want a connector or not found error", err)
+				}
+			}
+		})
+	}
+}
+
+func TestConnectorLifecycle(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	srv.ProvisioningPolls = 2
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+
+	cluster, err := c.CreateCluster(confluentcloud.ClusterCreateConfig{Name: "orders", AccountID: env, Region: "us-west-2", ServiceProvider: "aws"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateConnector(env, cluster.ID, "datagen", confluentcloud.ConnectorConfig{"connector.class": "DatagenSource"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.PauseConnector(env, cluster.ID, "datagen"); !confluentcloud.IsConflict(err) {
+		t.Errorf("got %v pausing a provisioning connector, want a conflict", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := c.WaitForConnectorRunningContext(ctx, env, cluster.ID, "datagen", fastPoll); err != nil {
+		t.Fatal(err)
+	}
+
+	state := func() confluentcloud.ConnectorState {
+		t.Helper()
+		status, err := c.GetConnectorStatus(env, cluster.ID, "datagen")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return status.Connector.State
+	}
+
+	if err := c.PauseConnector(env, cluster.ID, "datagen"); err != nil {
+		t.Fatal(err)
+	}
+	if got := state(); got != confluentcloud.ConnectorStatePaused {
+		t.Errorf("got state %s after pause, want %s", got, confluentcloud.ConnectorStatePaused)
+	}
+	if err := c.ResumeConnector(env, cluster.ID, "datagen"); err != nil {
+		t.Fatal(err)
+	}
+	if got := state(); got != confluentcloud.ConnectorStateRunning {
+		t.Errorf("got state %s after resume, want %s", got, confluentcloud.ConnectorStateRunning)
+	}
+
+	task := confluentcloud.ConnectorTask{ConnectorName: "datagen", TaskNo: 0}
+	if err := srv.FailConnectorTask(cluster.ID, task, "java.lang.OutOfMemoryError\n\tat Foo"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.WaitForConnectorRunningContext(ctx, env, cluster.ID, "datagen", fastPoll)
+	if failed, ok := err.(*confluentcloud.ConnectorFailedError); !ok || failed.Task == nil || !strings.Contains(failed.Trace, "OutOfMemoryError") {
+		t.Fatalf("got %v, want task 0 to have failed", err)
+	}
+
+	if err := c.RestartTask(env, cluster.ID, task); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RestartConnector(env, cluster.ID, "datagen"); err != nil {
+		t.Fatal(err)
+	}
+	status, err := c.WaitForConnectorRunningContext(ctx, env, cluster.ID, "datagen", fastPoll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Running() {
+		t.Errorf("got status %+v after the restarts, want running", status)
+	}
+
+	if err := c.RestartTask(env, cluster.ID, confluentcloud.ConnectorTask{ConnectorName: "datagen", TaskNo: 5}); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v restarting an unknown task, want not found", err)
+	}
+}
@@ -1,6 +1,7 @@
 package confluentcloud
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -16,7 +17,7 @@ type EnvironmentResponse struct {
 	Account Environment `json:"account"`
 }
 
-type EnvironmentsResponse struct { 
+type EnvironmentsResponse struct {
 	Accounts []Environment `json:"accounts"`
 }
 
@@ -30,6 +31,10 @@ type EnvironmentRequest struct {
 }
 
 func (c *Client) GetEnvironment(id string) (*Environment, error) {
+	return c.GetEnvironmentContext(context.Background(), id)
+}
+
+func (c *Client) GetEnvironmentContext(ctx context.Context, id string) (*Environment, error) {
 	rel, err := url.Parse(fmt.Sprintf("accounts/%s", id))
 	if err != nil {
 		return nil, err
@@ -38,8 +43,8 @@ func (c *Client) GetEnvironment(id string) (*Environment, error) {
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetResult(&EnvironmentResponse{}).
-		SetError(&ErrorResponse{}).
 		Get(u.String())
 
 	if err != nil {
@@ -47,13 +52,17 @@ func (c *Client) GetEnvironment(id string) (*Environment, error) {
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("get environment: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("get environment", response)
 	}
 
 	return &response.Result().(*EnvironmentResponse).Account, nil
 }
 
-func (c *Client) ListEnvironments() ([]Environment, error) { 
+func (c *Client) ListEnvironments() ([]Environment, error) {
+	return c.ListEnvironmentsContext(context.Background())
+}
+
+func (c *Client) ListEnvironmentsContext(ctx context.Context) ([]Environment, error) {
 	rel, err := url.Parse("accounts")
 	if err != nil {
 		return []Environment{}, err
@@ -62,22 +71,62 @@ func (c *Client) ListEnvironments() ([]Environment, error) {
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
-	SetResult(&EnvironmentsResponse{}).
-	SetError(&ErrorResponse{}).
-	Get(u.String())
+		SetContext(ctx).
+		SetResult(&EnvironmentsResponse{}).
+		Get(u.String())
 
 	if err != nil {
 		return []Environment{}, err
 	}
 
 	if response.IsError() {
-		return []Environment{}, fmt.Errorf("get environments: %s", response.Error().(*ErrorResponse).Error.Message)
+		return []Environment{}, newAPIError("get environments", response)
 	}
 
 	return response.Result().(*EnvironmentsResponse).Accounts, nil
 }
 
+// EnvironmentIterator iterates over the environments. The v1 API returns the
+// complete list, which is requested by the first call to Next.
+type EnvironmentIterator struct {
+	pager
+	page    []Environment
+	current Environment
+}
+
+// Next advances to the next environment. It returns false at the end of the
+// list or when the request failed; check Err to tell them apart.
+func (it *EnvironmentIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Environment returns the environment Next advanced to.
+func (it *EnvironmentIterator) Environment() Environment {
+	return it.current
+}
+
+// IterateEnvironments returns an iterator over the environments.
+func (c *Client) IterateEnvironments(ctx context.Context) *EnvironmentIterator {
+	it := &EnvironmentIterator{}
+	it.pager = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		var err error
+		it.page, err = c.ListEnvironmentsContext(ctx)
+		return "", err
+	}}
+	return it
+}
+
 func (c *Client) CreateEnvironment(name string, organizationID int) (*Environment, error) {
+	return c.CreateEnvironmentContext(context.Background(), name, organizationID)
+}
+
+func (c *Client) CreateEnvironmentContext(ctx context.Context, name string, organizationID int) (*Environment, error) {
 	rel, err := url.Parse("accounts")
 	if err != nil {
 		return nil, err
@@ -91,9 +140,9 @@ func (c *Client) CreateEnvironment(name string, organizationID int) (*Environmen
 	}
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(&EnvironmentCreateRequest{Account: request}).
 		SetResult(&EnvironmentResponse{}).
-		SetError(&ErrorResponse{}).
 		Post(u.String())
 
 	if err != nil {
@@ -101,13 +150,17 @@ func (c *Client) CreateEnvironment(name string, organizationID int) (*Environmen
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("environment: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("environment", response)
 	}
 
 	return &response.Result().(*EnvironmentResponse).Account, nil
 }
 
 func (c *Client) DeleteEnvironment(id string) error {
+	return c.DeleteEnvironmentContext(context.Background(), id)
+}
+
+func (c *Client) DeleteEnvironmentContext(ctx context.Context, id string) error {
 	rel, err := url.Parse(fmt.Sprintf("accounts/%s", id))
 	if err != nil {
 		return err
@@ -116,7 +169,7 @@ func (c *Client) DeleteEnvironment(id string) error {
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
-		SetError(&ErrorResponse{}).
+		SetContext(ctx).
 		Delete(u.String())
 
 	if err != nil {
@@ -124,13 +177,17 @@ func (c *Client) DeleteEnvironment(id string) error {
 	}
 
 	if response.IsError() {
-		return fmt.Errorf("delete environment: %s", response.Error().(*ErrorResponse).Error.Message)
+		return newAPIError("delete environment", response)
 	}
 
 	return nil
 }
 
 func (c *Client) UpdateEnvironment(id, newName string, organizationID int) (*Environment, error) {
+	return c.UpdateEnvironmentContext(context.Background(), id, newName, organizationID)
+}
+
+func (c *Client) UpdateEnvironmentContext(ctx context.Context, id, newName string, organizationID int) (*Environment, error) {
 	rel, err := url.Parse(fmt.Sprintf("accounts/%s", id))
 	if err != nil {
 		return nil, err
@@ -144,9 +201,9 @@ func (c *Client) UpdateEnvironment(id, newName string, organizationID int) (*Env
 	}
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(&EnvironmentCreateRequest{Account: request}).
 		SetResult(&EnvironmentResponse{}).
-		SetError(&ErrorResponse{}).
 		Put(u.String())
 
 	if err != nil {
@@ -154,7 +211,7 @@ func (c *Client) UpdateEnvironment(id, newName string, organizationID int) (*Env
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("environment: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("environment", response)
 	}
 
 	return &response.Result().(*EnvironmentResponse).Account, nil
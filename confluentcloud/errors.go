@@ -0,0 +1,115 @@
+package confluentcloud
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	resty "github.com/go-resty/resty/v2"
+)
+
+// APIError is returned when the API responds with an error status. Use
+// errors.As to inspect it, or one of the Is* helpers to branch on common
+// failure kinds.
+type APIError struct {
+	// Op names the failed operation, e.g. "get cluster".
+	Op string
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Code is the error code reported by the API, if any.
+	Code int
+	// Message is the error message reported by the API, or the HTTP status
+	// text if the response carried none.
+	Message string
+	// Method and URL identify the failed request.
+	Method string
+	URL    string
+	// Details maps request fields to the reason they failed validation.
+	Details map[string]string
+}
+
+func (e *APIError) Error() string {
+	if len(e.Details) == 0 {
+		return fmt.Sprintf("%s: %s", e.Op, e.Message)
+	}
+
+	fields := make([]string, 0, len(e.Details))
+	for field := range e.Details {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	details := make([]string, len(fields))
+	for i, field := range fields {
+		details[i] = fmt.Sprintf("%s: %s", field, e.Details[field])
+	}
+	return fmt.Sprintf("%s: %s (%s)", e.Op, e.Message, strings.Join(details, ", "))
+}
+
+// newAPIError builds an APIError from an error response. Most endpoints wrap
+// the ErrorMessage in an ErrorResponse, some return it bare, and the Kafka
+// REST and Schema Registry APIs report the code as error_code.
+func newAPIError(op string, response *resty.Response) *APIError {
+	e := &APIError{
+		Op:         op,
+		StatusCode: response.StatusCode(),
+		Method:     response.Request.Method,
+		URL:        response.Request.URL,
+	}
+
+	var wrapped ErrorResponse
+	var bare ErrorMessage
+	msg := &wrapped.Error
+	if err := json.Unmarshal(response.Body(), &wrapped); err != nil || (msg.Code == 0 && msg.Message == "") {
+		json.Unmarshal(response.Body(), &bare)
+		msg = &bare
+	}
+
+	e.Code = msg.Code
+	if e.Code == 0 {
+		var rest struct {
+			ErrorCode int `json:"error_code"`
+		}
+		json.Unmarshal(response.Body(), &rest)
+		e.Code = rest.ErrorCode
+	}
+	e.Message = msg.Message
+	e.Details = msg.NestedErrors
+	if e.Message == "" {
+		e.Message = http.StatusText(e.StatusCode)
+	}
+	return e
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// IsNotFound reports whether err is an APIError for a resource that does
+// not exist, or a SchemaRegistryNotFoundError.
+func IsNotFound(err error) bool {
+	var srErr *SchemaRegistryNotFoundError
+	return hasStatus(err, http.StatusNotFound) || errors.As(err, &srErr)
+}
+
+// IsConflict reports whether err is an APIError for a request that conflicts
+// with the current state of a resource, e.g. one that already exists.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is an APIError for a request whose
+// credentials were missing or rejected.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsRateLimited reports whether err is an APIError for a request that was
+// rejected because too many requests were sent.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
@@ -0,0 +1,90 @@
+package confluentcloud_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantCode    int
+		wantMessage string
+		wantDetails map[string]string
+		is          string
+	}{
+		{"wrapped", http.StatusNotFound, `{"error": {"code": 404, "message": "environment not found"}}`, 404, "environment not found", nil, "IsNotFound"},
+		{"wrapped with details", http.StatusBadRequest, `{"error": {"code": 400, "message": "invalid request", "nested_errors": {"name": "must not be empty"}}}`, 400, "invalid request", map[string]string{"name": "must not be empty"}, ""},
+		{"bare", http.StatusConflict, `{"code": 409, "message": "environment already exists"}`, 409, "environment already exists", nil, "IsConflict"},
+		{"error_code", http.StatusUnauthorized, `{"error_code": 40101, "message": "Unauthorized"}`, 40101, "Unauthorized", nil, "IsUnauthorized"},
+		{"empty body", http.StatusTooManyRequests, ``, 0, "Too Many Requests", nil, "IsRateLimited"},
+		{"not json", http.StatusBadGateway, `<html>Bad Gateway</html>`, 0, "Bad Gateway", nil, ""},
+	}
+
+	helpers := map[string]func(error) bool{
+		"IsNotFound":     confluentcloud.IsNotFound,
+		"IsConflict":     confluentcloud.IsConflict,
+		"IsUnauthorized": confluentcloud.IsUnauthorized,
+		"IsRateLimited":  confluentcloud.IsRateLimited,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			u, _ := url.Parse(srv.URL)
+			c := confluentcloud.NewClientWithAPIKey("key", "secret",
+				confluentcloud.WithBaseURL(u),
+				confluentcloud.WithRetryPolicy(confluentcloud.RetryPolicy{MaxAttempts: 1}))
+
+			_, err := c.GetEnvironment("env-1")
+			var apiErr *confluentcloud.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got %v, want an *APIError", err)
+			}
+			if apiErr.Op != "get environment" || apiErr.StatusCode != tt.status || apiErr.Method != http.MethodGet {
+				t.Errorf("got op %q, status %d, method %s", apiErr.Op, apiErr.StatusCode, apiErr.Method)
+			}
+			if apiErr.Code != tt.wantCode || apiErr.Message != tt.wantMessage {
+				t.Errorf("got code %d and message %q, want %d and %q", apiErr.Code, apiErr.Message, tt.wantCode, tt.wantMessage)
+			}
+			if len(apiErr.Details) != 0 || len(tt.wantDetails) != 0 {
+				if !reflect.DeepEqual(apiErr.Details, tt.wantDetails) {
+					t.Errorf("got details %v, want %v", apiErr.Details, tt.wantDetails)
+				}
+			}
+
+			for name, is := range helpers {
+				if got, want := is(err), name == tt.is; got != want {
+					t.Errorf("%s: got %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIErrorString(t *testing.T) {
+	err := &confluentcloud.APIError{
+		Op:      "create environment",
+		Message: "invalid request",
+		Details: map[string]string{"organization_id": "must be positive", "name": "must not be empty"},
+	}
+	want := "create environment: invalid request (name: must not be empty, organization_id: must be positive)"
+	if got := err.Error(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,67 @@
+package confluentcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	resty "github.com/go-resty/resty/v2"
+)
+
+// KafkaClient manages the resources inside one Kafka cluster, such as its
+// topics and ACLs, through the Kafka REST API at the cluster's APIEndpoint.
+// Create it with Client.NewKafkaClient.
+type KafkaClient struct {
+	// ClusterID is the ID of the cluster the client manages.
+	ClusterID string
+	// BaseURL is the cluster's Kafka REST base URL, e.g.
+	// https://pkac-1.us-east-1.aws.confluent.cloud/kafka/v3/clusters/lkc-1/.
+	BaseURL *url.URL
+
+	// The resource services of the client. They refer to the client itself
+	// and can be replaced by fakes in tests.
+	Topics TopicsService
+	ACLs   ACLsService
+
+	client *Client
+	auth   Authenticator
+}
+
+// NewKafkaClient returns a client for the Kafka REST API of cluster,
+// authenticated with key, an API key of that cluster. Requests share the
+// retry policy, rate limiters, logger and HTTP client of c.
+func (c *Client) NewKafkaClient(cluster *Cluster, key *APIKey) (*KafkaClient, error) {
+	if cluster == nil || key == nil {
+		return nil, errors.New("new kafka client: cluster and API key are required")
+	}
+	if cluster.APIEndpoint == "" {
+		return nil, fmt.Errorf("new kafka client: cluster %s has no API endpoint", cluster.ID)
+	}
+
+	base, err := url.Parse(fmt.Sprintf("%s/kafka/v3/clusters/%s/", strings.TrimSuffix(cluster.APIEndpoint, "/"), url.PathEscape(cluster.ID)))
+	if err != nil {
+		return nil, fmt.Errorf("new kafka client: %w", err)
+	}
+
+	k := &KafkaClient{
+		ClusterID: cluster.ID,
+		BaseURL:   base,
+		client:    c,
+		auth:      &APIKeyAuthenticator{Key: key.Key, Secret: key.Secret},
+	}
+	k.Topics = k
+	k.ACLs = k
+	return k, nil
+}
+
+// NewRequest returns a request authenticated with the cluster API key.
+func (k *KafkaClient) NewRequest(ctx context.Context) *resty.Request {
+	return k.client.NewRequest().SetContext(withAuthenticator(ctx, k.auth))
+}
+
+// url resolves a path against BaseURL; see resolve.
+func (k *KafkaClient) url(format string, args ...string) (string, error) {
+	return resolve(k.BaseURL, format, args...)
+}
@@ -0,0 +1,47 @@
+package confluentcloud
+
+import (
+	"fmt"
+)
+
+// Logger receives diagnostic messages from a Client. keysAndValues holds
+// alternating keys and values that describe the event.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// nopLogger discards all messages. It is the default Logger of a Client.
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, keysAndValues ...interface{}) {}
+func (nopLogger) Warn(msg string, keysAndValues ...interface{})  {}
+func (nopLogger) Error(msg string, keysAndValues ...interface{}) {}
+
+// restyLogger forwards the output of resty, including the request and
+// response dumps written in debug mode, to a Logger.
+type restyLogger struct {
+	logger Logger
+}
+
+func (l restyLogger) Errorf(format string, v ...interface{}) {
+	l.logger.Error(sprintf(format, v...))
+}
+
+func (l restyLogger) Warnf(format string, v ...interface{}) {
+	l.logger.Warn(sprintf(format, v...))
+}
+
+func (l restyLogger) Debugf(format string, v ...interface{}) {
+	l.logger.Debug(sprintf(format, v...))
+}
+
+// sprintf leaves format alone when there are no arguments, as resty passes
+// preformatted dumps that may contain verbs.
+func sprintf(format string, v ...interface{}) string {
+	if len(v) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, v...)
+}
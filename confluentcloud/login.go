@@ -1,7 +1,7 @@
 package confluentcloud
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 )
 
@@ -14,7 +14,25 @@ type AuthSuccessResponse struct {
 	Token string `json:"token"`
 }
 
+// loginCall is an in-flight login shared by all requests whose session
+// expired at the same time.
+type loginCall struct {
+	done chan struct{}
+	err  error
+}
+
+// Login obtains a session token with the email and password of the client.
+// It does nothing for clients that authenticate otherwise, e.g. with an API
+// key.
 func (c *Client) Login() error {
+	return c.LoginContext(context.Background())
+}
+
+func (c *Client) LoginContext(ctx context.Context) error {
+	if _, ok := c.Authenticator.(*SessionAuthenticator); !ok {
+		return nil
+	}
+
 	rel, err := url.Parse("sessions")
 	if err != nil {
 		return err
@@ -22,6 +40,7 @@ func (c *Client) Login() error {
 
 	u := c.BaseURL.ResolveReference(rel)
 	response, err := c.NewRequest().
+		SetContext(withAuthenticator(ctx, nil)).
 		SetBody(AuthRequest{Email: c.email, Password: c.password}).
 		SetResult(&AuthSuccessResponse{}).
 		Post(u.String())
@@ -31,11 +50,45 @@ func (c *Client) Login() error {
 	}
 
 	if response.IsError() {
-		return fmt.Errorf("login: %s", response.Error().(*ErrorResponse).Error.Message)
+		return newAPIError("login", response)
 	}
 
 	if response.IsSuccess() {
-		c.token = response.Result().(*AuthSuccessResponse).Token
+		c.setToken(response.Result().(*AuthSuccessResponse).Token)
 	}
 	return nil
 }
+
+// refreshSession logs in again after the API rejected the stale token. Only
+// one login is sent at a time; concurrent callers wait for its result, and
+// callers whose token was already replaced return immediately.
+func (c *Client) refreshSession(ctx context.Context, stale string) error {
+	c.mu.Lock()
+	if c.token != stale {
+		c.mu.Unlock()
+		return nil
+	}
+
+	if call := c.refreshing; call != nil {
+		c.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	call := &loginCall{done: make(chan struct{})}
+	c.refreshing = call
+	c.mu.Unlock()
+
+	call.err = c.LoginContext(ctx)
+
+	c.mu.Lock()
+	c.refreshing = nil
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.err
+}
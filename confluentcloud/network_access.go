@@ -0,0 +1,126 @@
+package confluentcloud
+
+import (
+	"fmt"
+	"net"
+)
+
+// ClusterDeploymentNetworkAccess lists the ways a cluster can be reached.
+// Each mode is enabled by one or more entries.
+type ClusterDeploymentNetworkAccess struct {
+	PublicInternet []PublicInternetAccess `json:"public_internet"`
+	VpcPeering     []VpcPeeringAccess     `json:"vpc_peering"`
+	PrivateLink    []PrivateLinkAccess    `json:"private_link"`
+	TransitGateway []TransitGatewayAccess `json:"transit_gateway"`
+}
+
+// PublicInternetAccess makes the cluster reachable over the internet.
+type PublicInternetAccess struct {
+	// CIDRs restricts access to clients from these networks. Leave it
+	// empty to allow all clients.
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+// VpcPeeringAccess peers the network of the cluster with a network of the
+// customer.
+type VpcPeeringAccess struct {
+	// CIDR is the network of the cluster. It must not overlap with the
+	// peered network.
+	CIDR string `json:"cidr"`
+	// AccountID is the AWS account, GCP project or Azure tenant of the
+	// peered network.
+	AccountID string `json:"account_id"`
+	// NetworkID is the AWS VPC, GCP VPC network or Azure VNet to peer with.
+	NetworkID      string   `json:"network_id"`
+	CustomerRegion string   `json:"customer_region,omitempty"`
+	Routes         []string `json:"routes,omitempty"`
+}
+
+// PrivateLinkAccess makes the cluster reachable through private endpoints
+// of the allowed cloud accounts.
+type PrivateLinkAccess struct {
+	// AllowedAccounts lists the AWS accounts, GCP projects or Azure
+	// subscriptions that may connect.
+	AllowedAccounts []string `json:"allowed_accounts"`
+}
+
+// TransitGatewayAccess attaches the network of the cluster to an AWS transit
+// gateway.
+type TransitGatewayAccess struct {
+	// CIDR is the network of the cluster.
+	CIDR             string   `json:"cidr"`
+	TransitGatewayID string   `json:"transit_gateway_id"`
+	AccountID        string   `json:"account_id"`
+	RAMShareARN      string   `json:"ram_share_arn"`
+	Routes           []string `json:"routes,omitempty"`
+}
+
+// validate checks the network access of a new cluster. Only dedicated
+// clusters support network access other than the default.
+func (n *ClusterDeploymentNetworkAccess) validate(sku ClusterSku, provider ServiceProvider) error {
+	if sku != ClusterSkuDedicated {
+		return &ValidationError{Field: "deployment.network_access", Reason: "only dedicated clusters support network access configuration"}
+	}
+
+	for i, access := range n.PublicInternet {
+		for _, cidr := range access.CIDRs {
+			if err := validateCIDR(fmt.Sprintf("public_internet[%d].cidrs", i), cidr); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, access := range n.VpcPeering {
+		field := fmt.Sprintf("vpc_peering[%d]", i)
+		if err := validateCIDR(field+".cidr", access.CIDR); err != nil {
+			return err
+		}
+		if access.AccountID == "" {
+			return &ValidationError{Field: field + ".account_id", Reason: "must not be empty"}
+		}
+		if access.NetworkID == "" {
+			return &ValidationError{Field: field + ".network_id", Reason: "must not be empty"}
+		}
+		for _, route := range access.Routes {
+			if err := validateCIDR(field+".routes", route); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, access := range n.PrivateLink {
+		if len(access.AllowedAccounts) == 0 {
+			return &ValidationError{Field: fmt.Sprintf("private_link[%d].allowed_accounts", i), Reason: "must not be empty"}
+		}
+	}
+
+	if len(n.TransitGateway) > 0 && provider != ServiceProviderAWS {
+		return &ValidationError{Field: "transit_gateway", Reason: "is only available on AWS"}
+	}
+	for i, access := range n.TransitGateway {
+		field := fmt.Sprintf("transit_gateway[%d]", i)
+		if err := validateCIDR(field+".cidr", access.CIDR); err != nil {
+			return err
+		}
+		if access.TransitGatewayID == "" {
+			return &ValidationError{Field: field + ".transit_gateway_id", Reason: "must not be empty"}
+		}
+		if access.RAMShareARN == "" {
+			return &ValidationError{Field: field + ".ram_share_arn", Reason: "must not be empty"}
+		}
+		for _, route := range access.Routes {
+			if err := validateCIDR(field+".routes", route); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateCIDR(field, cidr string) error {
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("%q is not a CIDR", cidr)}
+	}
+	return nil
+}
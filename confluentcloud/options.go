@@ -0,0 +1,97 @@
+package confluentcloud
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ClientOption configures a Client created by NewClient or
+// NewClientWithAPIKey.
+type ClientOption func(*Client)
+
+// WithBaseURL sends requests to baseURL instead of the Confluent Cloud API,
+// e.g. to a local stand-in server.
+func WithBaseURL(baseURL *url.URL) ClientOption {
+	return func(c *Client) {
+		u := *baseURL
+		// Resource paths are resolved relative to the base URL.
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.BaseURL = &u
+	}
+}
+
+// WithHTTPClient sends requests through a copy of hc, e.g. one configured with
+// a proxy or custom TLS settings.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithTimeout limits the time a single request may take.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithUserAgent appends product, e.g. "my-tool/1.2", to the User-Agent
+// header sent with every request.
+func WithUserAgent(product string) ClientOption {
+	return func(c *Client) {
+		c.UserAgent += " " + product
+	}
+}
+
+// WithDebug turns logging of every request and response on or off. The dumps
+// are written to the Logger at debug level with credentials, tokens, API
+// secrets and sensitive connector config values redacted.
+func WithDebug(debug bool) ClientOption {
+	return func(c *Client) {
+		c.debug = debug
+	}
+}
+
+// WithLogger sends the diagnostic messages of the client to logger. By default
+// they are discarded.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Client) {
+		if logger == nil {
+			logger = nopLogger{}
+		}
+		c.logger = logger
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. Pass a policy with MaxAttempts
+// of 1 to turn retries off.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRateLimiter makes every request of the client, including retries, wait
+// for limiter. A limiter may be shared by several clients.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithEndpointRateLimiter makes requests to one endpoint family wait for
+// limiter, in addition to the limiter set by WithRateLimiter. The family is
+// the name of the resource collection, e.g. "clusters", "api_keys",
+// "service_accounts", "accounts" or "connectors".
+func WithEndpointRateLimiter(family string, limiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		if c.endpointRateLimiters == nil {
+			c.endpointRateLimiters = make(map[string]*RateLimiter)
+		}
+		c.endpointRateLimiters[family] = limiter
+	}
+}
@@ -0,0 +1,68 @@
+package confluentcloud
+
+import (
+	"context"
+	"strconv"
+
+	resty "github.com/go-resty/resty/v2"
+)
+
+// The iam/v2 list endpoints are paginated: they accept page_size and
+// page_token query parameters and return the URL of the following page in
+// metadata.next, see
+// https://docs.confluent.io/cloud/current/api.html#section/Pagination.
+// The v1 endpoints return complete lists. Their iterators request the list
+// once, so that every list can be iterated the same way.
+
+// ListOptions controls how IterateRoleBindings pages through the list.
+type ListOptions struct {
+	// PageSize is the number of items requested per page. Zero leaves the
+	// page size to the API.
+	PageSize int
+}
+
+// setPage adds the page size and token to a list request.
+func setPage(request *resty.Request, opts *ListOptions, token string) *resty.Request {
+	if opts != nil && opts.PageSize > 0 {
+		request.SetQueryParam("page_size", strconv.Itoa(opts.PageSize))
+	}
+	if token != "" {
+		request.SetQueryParam("page_token", token)
+	}
+	return request
+}
+
+// pager requests the pages of a list one after another. fetch loads the
+// page for token into the iterator embedding the pager and returns the
+// token of the following page, or "" after the last page.
+type pager struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, token string) (string, error)
+	token string
+	done  bool
+	err   error
+}
+
+// nextPage loads the next page. It reports false when the last page has
+// been loaded or a request failed.
+func (p *pager) nextPage() bool {
+	if p.done {
+		return false
+	}
+
+	next, err := p.fetch(p.ctx, p.token)
+	if err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+
+	p.token = next
+	p.done = next == ""
+	return true
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *pager) Err() error {
+	return p.err
+}
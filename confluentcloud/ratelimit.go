@@ -0,0 +1,106 @@
+package confluentcloud
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that limits the rate of requests sent by one
+// or more clients. It is safe for concurrent use.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that allows requestsPerSecond on
+// average and bursts of up to burst requests. A requestsPerSecond that is not
+// positive makes the limiter unlimited.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if !(requestsPerSecond > 0) {
+		requestsPerSecond = 0
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l.rate == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// Take the token right away, so that later callers queue behind this
+	// one, and wait until the bucket would have refilled it.
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// waitForRateLimit waits for the client-wide rate limiter and the limiter of
+// the endpoint family of req, if any.
+func (c *Client) waitForRateLimit(req *http.Request) error {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(req.Context()); err != nil {
+			return err
+		}
+	}
+
+	if limiter, ok := c.endpointRateLimiters[c.endpointFamily(req)]; ok {
+		return limiter.Wait(req.Context())
+	}
+	return nil
+}
+
+// endpointFamily returns the resource collection addressed by req, e.g.
+// "clusters" for clusters/lkc-1, "connectors" for
+// accounts/env-1/clusters/lkc-1/connectors/name or "topics" for the Kafka
+// REST path kafka/v3/clusters/lkc-1/topics/name.
+func (c *Client) endpointFamily(req *http.Request) string {
+	path := strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) >= 5 && segments[0] == "accounts" && segments[2] == "clusters" {
+		return segments[4]
+	}
+	if len(segments) >= 5 && segments[0] == "kafka" && segments[2] == "clusters" {
+		return segments[4]
+	}
+	return segments[0]
+}
@@ -0,0 +1,78 @@
+package confluentcloud
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := NewRateLimiter(10, 2)
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The bucket is empty; the next token takes 100ms to refill.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	start := time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("waited %s, want about 100ms", elapsed)
+	}
+}
+
+func TestRateLimiterWithoutPositiveRateIsUnlimited(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN()} {
+		l := NewRateLimiter(rate, 1)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		for i := 0; i < 100; i++ {
+			if err := l.Wait(ctx); err != nil {
+				t.Fatalf("rate %v: %v", rate, err)
+			}
+		}
+		cancel()
+	}
+}
+
+func TestEndpointFamily(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/api/clusters", "clusters"},
+		{"/api/clusters/lkc-1", "clusters"},
+		{"/api/api_keys/12", "api_keys"},
+		{"/api/accounts/env-1", "accounts"},
+		{"/api/accounts/env-1/clusters/lkc-1/connectors", "connectors"},
+		{"/api/accounts/env-1/clusters/lkc-1/connectors/name/status", "connectors"},
+		{"/api/accounts/env-1/clusters/lkc-1/connector-plugins", "connector-plugins"},
+		{"/api/kafka/v3/clusters/lkc-1/topics/orders", "topics"},
+		{"/api/kafka/v3/clusters/lkc-1/acls", "acls"},
+		{"/api/kafka/v3/clusters/lkc-1", "kafka"},
+		{"/api/", ""},
+	}
+
+	base, _ := url.Parse(defaultBaseURL)
+	c := &Client{BaseURL: base}
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodGet, "https://confluent.cloud"+tt.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.endpointFamily(req); got != tt.want {
+			t.Errorf("endpointFamily(%s) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
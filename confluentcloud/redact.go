@@ -0,0 +1,122 @@
+package confluentcloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	resty "github.com/go-resty/resty/v2"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders carry credentials and are never logged.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveKeyParts identify JSON keys, including connector config keys such
+// as "connection.password" or "aws.secret.access.key", whose values are
+// never logged.
+var sensitiveKeyParts = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"credential",
+	"private",
+	"jaas",
+}
+
+// sensitiveKeySuffixes identify connector config keys that hold a key, such
+// as "azure.storage.account.key". A plain "key" is the ID of an API key and
+// is logged.
+var sensitiveKeySuffixes = []string{
+	".key",
+	"_key",
+}
+
+// insensitiveKeys match a sensitive suffix but only wrap other fields, such
+// as the api_key object of API key requests and responses, whose secret is
+// masked on its own.
+var insensitiveKeys = map[string]bool{
+	"api_key": true,
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	if insensitiveKeys[key] {
+		return false
+	}
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactRequestLog(l *resty.RequestLog) error {
+	redactHeader(l.Header)
+	l.Body = redactBody(l.Body)
+	return nil
+}
+
+func redactResponseLog(l *resty.ResponseLog) error {
+	redactHeader(l.Header)
+	l.Body = redactBody(l.Body)
+	return nil
+}
+
+func redactHeader(h http.Header) {
+	for _, name := range sensitiveHeaders {
+		if _, ok := h[name]; ok {
+			h.Set(name, redacted)
+		}
+	}
+}
+
+// redactBody masks the values of sensitive keys in a JSON body. Anything that
+// is not JSON is returned unchanged.
+func redactBody(body string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+
+	b, err := json.MarshalIndent(redactValue(v), "", "   ")
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+// redactValue masks the values of sensitive keys in v, whatever their type,
+// and looks for sensitive keys in all other values.
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveKey(key) {
+				if value != nil {
+					v[key] = redacted
+				}
+			} else {
+				v[key] = redactValue(value)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+	return v
+}
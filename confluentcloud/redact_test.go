@@ -0,0 +1,97 @@
+package confluentcloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			"login request",
+			`{"email": "user@example.com", "password": "hunter2"}`,
+			`{"email": "user@example.com", "password": "[REDACTED]"}`,
+		},
+		{
+			"login response",
+			`{"token": "eyJhbGciOi", "user": {"id": 1}}`,
+			`{"token": "[REDACTED]", "user": {"id": 1}}`,
+		},
+		{
+			"api key response",
+			`{"api_key": {"key": "ABCDEFGH", "secret": "s3cr3t", "id": 7, "logical_clusters": [{"id": "lkc-1"}]}}`,
+			`{"api_key": {"key": "ABCDEFGH", "secret": "[REDACTED]", "id": 7, "logical_clusters": [{"id": "lkc-1"}]}}`,
+		},
+		{
+			"connector config",
+			`{"name": "jdbc", "config": {"connection.user": "app", "connection.password": "pw", "sasl.jaas.config": "org.apache.kafka.common.security.plain.PlainLoginModule required password=\"pw\";"}}`,
+			`{"name": "jdbc", "config": {"connection.user": "app", "connection.password": "[REDACTED]", "sasl.jaas.config": "[REDACTED]"}}`,
+		},
+		{
+			"connector keys",
+			`{"azure.storage.account.key": "k1", "kafka.api.key": "k2", "private_key": "k3", "key": "ABCDEFGH"}`,
+			`{"azure.storage.account.key": "[REDACTED]", "kafka.api.key": "[REDACTED]", "private_key": "[REDACTED]", "key": "ABCDEFGH"}`,
+		},
+		{
+			"sensitive object and array",
+			`{"credentials": {"user": "app", "pass": "pw"}, "tokens": ["a", "b"], "secret": null}`,
+			`{"credentials": "[REDACTED]", "tokens": "[REDACTED]", "secret": null}`,
+		},
+		{
+			"nested in array",
+			`[{"name": "a", "password": "pw"}]`,
+			`[{"name": "a", "password": "[REDACTED]"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got, want interface{}
+			if err := json.Unmarshal([]byte(redactBody(tt.body)), &got); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRedactBodyLeavesNonJSON(t *testing.T) {
+	for _, body := range []string{"", "password=hunter2", "<html>Bad Gateway</html>"} {
+		if got := redactBody(body); got != body {
+			t.Errorf("got %q, want %q unchanged", got, body)
+		}
+	}
+}
+
+func TestRedactHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer eyJhbGciOi")
+	h.Set("Cookie", "session=abc")
+	h.Set("Content-Type", "application/json")
+
+	redactHeader(h)
+
+	if got := h.Get("Authorization"); got != redacted {
+		t.Errorf("got Authorization %q, want it redacted", got)
+	}
+	if got := h.Get("Cookie"); got != redacted {
+		t.Errorf("got Cookie %q, want it redacted", got)
+	}
+	if got := h.Get("Content-Type"); got != "application/json" {
+		t.Errorf("got Content-Type %q, want it unchanged", got)
+	}
+	if _, ok := h["Proxy-Authorization"]; ok {
+		t.Error("got a Proxy-Authorization header that was not set")
+	}
+}
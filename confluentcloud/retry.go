@@ -0,0 +1,98 @@
+package confluentcloud
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy controls how a Client retries requests that failed with a
+// transient error. Idempotent requests are retried after network errors,
+// 429 Too Many Requests and 5xx responses. Other requests, such as creates,
+// are only retried after 429 Too Many Requests, which guarantees the API did
+// not process them.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts of a request, including
+	// the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the wait between attempts. The wait
+	// grows exponentially with jitter, unless the response carries a
+	// Retry-After header, which is honored up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is used by clients created without WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+func (p RetryPolicy) apply(client *resty.Client) {
+	if p.MaxAttempts < 2 {
+		return
+	}
+
+	// resty counts the first attempt as a retry.
+	client.SetRetryCount(p.MaxAttempts)
+	client.SetRetryWaitTime(p.MinBackoff)
+	client.SetRetryMaxWaitTime(p.MaxBackoff)
+	client.SetRetryAfter(retryAfter)
+	client.AddRetryCondition(shouldRetry)
+}
+
+func shouldRetry(response *resty.Response, err error) bool {
+	if response == nil || response.Request == nil {
+		return false
+	}
+
+	method := response.Request.Method
+	status := response.StatusCode()
+	if status == 0 {
+		// No response was received. Errors from the API itself, e.g. a
+		// failed login while refreshing the session, are not transient.
+		var apiErr *APIError
+		return err != nil && !errors.As(err, &apiErr) && isIdempotent(method)
+	}
+
+	switch {
+	case status == http.StatusTooManyRequests:
+		return true
+	case status >= 500 && status != http.StatusNotImplemented:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait requested by the Retry-After header of the
+// response, either in seconds or as an HTTP date. A zero duration makes resty
+// fall back to exponential backoff.
+func retryAfter(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+	value := response.Header().Get("Retry-After")
+	if value == "" {
+		return 0, nil
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, nil
+		}
+	}
+	return 0, nil
+}
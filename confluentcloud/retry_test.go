@@ -0,0 +1,139 @@
+package confluentcloud_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	get := func(c *confluentcloud.Client) error {
+		_, err := c.GetEnvironment("env-1")
+		return err
+	}
+	create := func(c *confluentcloud.Client) error {
+		_, err := c.CreateEnvironment("env", 1)
+		return err
+	}
+	del := func(c *confluentcloud.Client) error {
+		return c.DeleteEnvironment("env-1")
+	}
+
+	tests := []struct {
+		name     string
+		call     func(*confluentcloud.Client) error
+		statuses []int
+		attempts int
+		wantErr  bool
+	}{
+		{"get after 503", get, []int{503, 200}, 2, false},
+		{"get after 429", get, []int{429, 429, 200}, 3, false},
+		{"get gives up", get, []int{500, 500, 500, 500}, 3, true},
+		{"get not implemented", get, []int{501, 200}, 1, true},
+		{"get not found", get, []int{404, 200}, 1, true},
+		{"delete after 502", del, []int{502, 200}, 2, false},
+		{"create after 429", create, []int{429, 200}, 2, false},
+		{"create after 503", create, []int{503, 200}, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				status := tt.statuses[attempts]
+				attempts++
+				mu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					fmt.Fprint(w, `{"account":{"id":"env-1"}}`)
+				} else {
+					fmt.Fprintf(w, `{"error":{"code":%d,"message":"failed"}}`, status)
+				}
+			}))
+			defer srv.Close()
+
+			u, _ := url.Parse(srv.URL)
+			c := confluentcloud.NewClientWithAPIKey("key", "secret",
+				confluentcloud.WithBaseURL(u),
+				confluentcloud.WithRetryPolicy(confluentcloud.RetryPolicy{
+					MaxAttempts: 3,
+					MinBackoff:  time.Millisecond,
+					MaxBackoff:  5 * time.Millisecond,
+				}))
+
+			err := tt.call(c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error: %v", err, tt.wantErr)
+			}
+			if attempts != tt.attempts {
+				t.Errorf("got %d attempts, want %d", attempts, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestRetryAfterIsCappedByMaxBackoff(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"account":{"id":"env-1"}}`)
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	c := confluentcloud.NewClientWithAPIKey("key", "secret",
+		confluentcloud.WithBaseURL(u),
+		confluentcloud.WithRetryPolicy(confluentcloud.RetryPolicy{
+			MaxAttempts: 2,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  10 * time.Millisecond,
+		}))
+
+	start := time.Now()
+	if _, err := c.GetEnvironment("env-1"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %s, want at most MaxBackoff", elapsed)
+	}
+	if attempts != 2 {
+		t.Errorf("got %d attempts, want 2", attempts)
+	}
+}
+
+func TestRetriesDisabled(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	c := confluentcloud.NewClientWithAPIKey("key", "secret",
+		confluentcloud.WithBaseURL(u),
+		confluentcloud.WithRetryPolicy(confluentcloud.RetryPolicy{MaxAttempts: 1}))
+
+	if _, err := c.GetEnvironment("env-1"); err == nil {
+		t.Fatal("got no error")
+	}
+	if attempts != 1 {
+		t.Errorf("got %d attempts, want 1", attempts)
+	}
+}
@@ -0,0 +1,406 @@
+package confluentcloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const crnAuthority = "crn://confluent.cloud"
+
+// Role is a predefined RBAC role, e.g. CloudClusterAdmin or DeveloperRead.
+type Role struct {
+	Name     string       `json:"name"`
+	Policies []RolePolicy `json:"policies"`
+}
+
+// RolePolicy lists the operations a role grants at a scope.
+type RolePolicy struct {
+	// BindingScope is the kind of scope the role is bound at, e.g.
+	// "cluster" or "resource".
+	BindingScope      string          `json:"binding_scope"`
+	AllowedOperations []RoleOperation `json:"allowed_operations"`
+}
+
+// RoleOperation lists the operations granted on one resource type.
+type RoleOperation struct {
+	ResourceType string   `json:"resource_type"`
+	Operations   []string `json:"operations"`
+}
+
+type RolesResponse struct {
+	Data []Role `json:"data"`
+}
+
+// RoleBinding grants a role to a principal on the resources matched by a
+// CRN pattern.
+type RoleBinding struct {
+	ID         string `json:"id,omitempty"`
+	Principal  string `json:"principal"`
+	RoleName   string `json:"role_name"`
+	CRNPattern string `json:"crn_pattern"`
+}
+
+type RoleBindingsResponse struct {
+	Data     []RoleBinding `json:"data"`
+	Metadata ListMetadata  `json:"metadata"`
+}
+
+// ListMetadata is returned with every page of the iam/v2 lists. Next is the
+// URL of the following page, empty on the last one.
+type ListMetadata struct {
+	Next string `json:"next,omitempty"`
+}
+
+// pageToken returns the page token of the following page from m.Next.
+func (m ListMetadata) pageToken() (string, error) {
+	if m.Next == "" {
+		return "", nil
+	}
+	next, err := url.Parse(m.Next)
+	if err != nil {
+		return "", err
+	}
+	return next.Query().Get("page_token"), nil
+}
+
+// UserPrincipal returns the RBAC principal of the user with the given ID,
+// e.g. User:12345. Users and service accounts share the User: prefix, so it
+// is the same as ServiceAccountPrincipal; it exists so that role bindings of
+// users read as such.
+func UserPrincipal(id int) string {
+	return ServiceAccountPrincipal(id)
+}
+
+// RoleBindingScope identifies the resources a role binding applies to: an
+// organization, an environment, a cluster, or a topic or subject. Build it
+// with OrganizationScope or AccountScope and narrow it with the With
+// methods. Topic and Subject may end in * to match by prefix.
+type RoleBindingScope struct {
+	OrganizationID   int
+	EnvironmentID    string
+	ClusterID        string
+	Topic            string
+	SchemaRegistryID string
+	Subject          string
+}
+
+// OrganizationScope returns the scope of a whole organization.
+func OrganizationScope(organizationID int) RoleBindingScope {
+	return RoleBindingScope{OrganizationID: organizationID}
+}
+
+// AccountScope returns the scope of the environment account belongs to,
+// e.g. the Account returned by Me.
+func AccountScope(account AccountMessage) RoleBindingScope {
+	return OrganizationScope(account.OrganizationID).WithEnvironment(account.ID)
+}
+
+// WithEnvironment narrows s to the environment with the given ID.
+func (s RoleBindingScope) WithEnvironment(id string) RoleBindingScope {
+	s.EnvironmentID = id
+	return s
+}
+
+// WithCluster narrows s to the Kafka cluster with the given ID.
+func (s RoleBindingScope) WithCluster(id string) RoleBindingScope {
+	s.ClusterID = id
+	return s
+}
+
+// WithTopic narrows a cluster scope to a topic.
+func (s RoleBindingScope) WithTopic(name string) RoleBindingScope {
+	s.Topic = name
+	return s
+}
+
+// WithSchemaRegistry narrows s to the Schema Registry with the given ID.
+func (s RoleBindingScope) WithSchemaRegistry(id string) RoleBindingScope {
+	s.SchemaRegistryID = id
+	return s
+}
+
+// WithSubject narrows a Schema Registry scope to a subject.
+func (s RoleBindingScope) WithSubject(name string) RoleBindingScope {
+	s.Subject = name
+	return s
+}
+
+// Validate checks that every part of the scope has the parts it is nested
+// in.
+func (s RoleBindingScope) Validate() error {
+	switch {
+	case s.OrganizationID == 0:
+		return &ValidationError{Field: "organization", Reason: "must not be empty"}
+	case s.EnvironmentID == "" && (s.ClusterID != "" || s.SchemaRegistryID != ""):
+		return &ValidationError{Field: "environment", Reason: "must not be empty for cluster and schema registry scopes"}
+	case s.ClusterID == "" && s.Topic != "":
+		return &ValidationError{Field: "cluster", Reason: "must not be empty for topic scopes"}
+	case s.SchemaRegistryID == "" && s.Subject != "":
+		return &ValidationError{Field: "schema_registry", Reason: "must not be empty for subject scopes"}
+	case s.ClusterID != "" && s.SchemaRegistryID != "":
+		return &ValidationError{Field: "schema_registry", Reason: "must be empty for cluster scopes"}
+	}
+	return nil
+}
+
+// CRN returns the Confluent resource name of the scope, e.g.
+// crn://confluent.cloud/organization=1/environment=env-1/cloud-cluster=lkc-1.
+func (s RoleBindingScope) CRN() string {
+	crn := fmt.Sprintf("%s/organization=%d", crnAuthority, s.OrganizationID)
+	if s.EnvironmentID != "" {
+		crn += "/environment=" + s.EnvironmentID
+	}
+	if s.ClusterID != "" {
+		crn += "/cloud-cluster=" + s.ClusterID
+		if s.Topic != "" {
+			crn += "/kafka=" + s.ClusterID + "/topic=" + s.Topic
+		}
+	}
+	if s.SchemaRegistryID != "" {
+		crn += "/schema-registry=" + s.SchemaRegistryID
+		if s.Subject != "" {
+			crn += "/subject=" + s.Subject
+		}
+	}
+	return crn
+}
+
+// appliesTo reports whether a binding with the given CRN pattern applies to
+// the resource named by crn: the pattern names the resource or one of its
+// ancestors, or ends in a wildcard matching the resource.
+func appliesTo(pattern, crn string) bool {
+	if pattern == crn || strings.HasPrefix(crn, pattern+"/") {
+		return true
+	}
+	if !strings.HasSuffix(pattern, "*") {
+		return false
+	}
+
+	// A wildcard only matches within the last part of the pattern, e.g.
+	// topic=orders-* matches topic=orders-eu but not another cluster.
+	prefix := strings.TrimSuffix(pattern, "*")
+	if !strings.HasPrefix(crn, prefix) {
+		return false
+	}
+	rest := crn[len(prefix):]
+	if i := strings.Index(rest, "/"); i >= 0 {
+		rest = rest[:i]
+	}
+	return !strings.Contains(rest, "=")
+}
+
+// RoleBindingFilter selects role bindings. Empty fields match every value.
+type RoleBindingFilter struct {
+	Principal string
+	RoleName  string
+	// Scope, if set, matches the bindings made exactly at the scope.
+	Scope *RoleBindingScope
+}
+
+func (f RoleBindingFilter) params() map[string]string {
+	params := make(map[string]string)
+	if f.Principal != "" {
+		params["principal"] = f.Principal
+	}
+	if f.RoleName != "" {
+		params["role_name"] = f.RoleName
+	}
+	if f.Scope != nil {
+		params["crn_pattern"] = f.Scope.CRN()
+	}
+	return params
+}
+
+func (c *Client) ListRoles() ([]Role, error) {
+	return c.ListRolesContext(context.Background())
+}
+
+func (c *Client) ListRolesContext(ctx context.Context) ([]Role, error) {
+	rel, err := url.Parse("iam/v2/roles")
+	if err != nil {
+		return []Role{}, err
+	}
+
+	u := c.BaseURL.ResolveReference(rel)
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetResult(&RolesResponse{}).
+		Get(u.String())
+
+	if err != nil {
+		return []Role{}, err
+	}
+
+	if response.IsError() {
+		return []Role{}, newAPIError("list roles", response)
+	}
+
+	return response.Result().(*RolesResponse).Data, nil
+}
+
+func (c *Client) ListRoleBindings(filter RoleBindingFilter) ([]RoleBinding, error) {
+	return c.ListRoleBindingsContext(context.Background(), filter)
+}
+
+func (c *Client) ListRoleBindingsContext(ctx context.Context, filter RoleBindingFilter) ([]RoleBinding, error) {
+	list := []RoleBinding{}
+	it := c.IterateRoleBindings(ctx, filter, nil)
+	for it.Next() {
+		list = append(list, it.RoleBinding())
+	}
+	if err := it.Err(); err != nil {
+		return []RoleBinding{}, err
+	}
+	return list, nil
+}
+
+// RoleBindingIterator iterates over role bindings, requesting one page at a
+// time.
+type RoleBindingIterator struct {
+	pager
+	page    []RoleBinding
+	current RoleBinding
+}
+
+// Next advances to the next role binding. It returns false at the end of the
+// list or when a request failed; check Err to tell them apart.
+func (it *RoleBindingIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// RoleBinding returns the role binding Next advanced to.
+func (it *RoleBindingIterator) RoleBinding() RoleBinding {
+	return it.current
+}
+
+// IterateRoleBindings returns an iterator over the role bindings matched by
+// filter.
+func (c *Client) IterateRoleBindings(ctx context.Context, filter RoleBindingFilter, opts *ListOptions) *RoleBindingIterator {
+	it := &RoleBindingIterator{}
+	it.pager = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		rel, err := url.Parse("iam/v2/role-bindings")
+		if err != nil {
+			return "", err
+		}
+
+		u := c.BaseURL.ResolveReference(rel)
+		response, err := setPage(c.NewRequest(), opts, token).
+			SetContext(ctx).
+			SetQueryParams(filter.params()).
+			SetResult(&RoleBindingsResponse{}).
+			Get(u.String())
+
+		if err != nil {
+			return "", err
+		}
+
+		if response.IsError() {
+			return "", newAPIError("list role bindings", response)
+		}
+
+		result := response.Result().(*RoleBindingsResponse)
+		it.page = result.Data
+		return result.Metadata.pageToken()
+	}}
+	return it
+}
+
+// CreateRoleBinding grants roleName to principal at scope.
+func (c *Client) CreateRoleBinding(principal, roleName string, scope RoleBindingScope) (*RoleBinding, error) {
+	return c.CreateRoleBindingContext(context.Background(), principal, roleName, scope)
+}
+
+func (c *Client) CreateRoleBindingContext(ctx context.Context, principal, roleName string, scope RoleBindingScope) (*RoleBinding, error) {
+	if principal == "" {
+		return nil, &ValidationError{Field: "principal", Reason: "must not be empty"}
+	}
+	if roleName == "" {
+		return nil, &ValidationError{Field: "role_name", Reason: "must not be empty"}
+	}
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+
+	rel, err := url.Parse("iam/v2/role-bindings")
+	if err != nil {
+		return nil, err
+	}
+
+	u := c.BaseURL.ResolveReference(rel)
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetBody(&RoleBinding{Principal: principal, RoleName: roleName, CRNPattern: scope.CRN()}).
+		SetResult(&RoleBinding{}).
+		Post(u.String())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, newAPIError("create role binding", response)
+	}
+
+	return response.Result().(*RoleBinding), nil
+}
+
+func (c *Client) DeleteRoleBinding(id string) error {
+	return c.DeleteRoleBindingContext(context.Background(), id)
+}
+
+func (c *Client) DeleteRoleBindingContext(ctx context.Context, id string) error {
+	rel, err := url.Parse("iam/v2/role-bindings/" + url.PathEscape(id))
+	if err != nil {
+		return err
+	}
+
+	u := c.BaseURL.ResolveReference(rel)
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		Delete(u.String())
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError("delete role binding", response)
+	}
+
+	return nil
+}
+
+// EffectiveRoleBindings returns the role bindings that apply to principal
+// at scope: those made at the scope itself, at any scope it is nested in,
+// and wildcard bindings matching it.
+func (c *Client) EffectiveRoleBindings(principal string, scope RoleBindingScope) ([]RoleBinding, error) {
+	return c.EffectiveRoleBindingsContext(context.Background(), principal, scope)
+}
+
+func (c *Client) EffectiveRoleBindingsContext(ctx context.Context, principal string, scope RoleBindingScope) ([]RoleBinding, error) {
+	if err := scope.Validate(); err != nil {
+		return []RoleBinding{}, err
+	}
+
+	bindings, err := c.ListRoleBindingsContext(ctx, RoleBindingFilter{Principal: principal})
+	if err != nil {
+		return []RoleBinding{}, err
+	}
+
+	crn := scope.CRN()
+	effective := []RoleBinding{}
+	for _, binding := range bindings {
+		if appliesTo(binding.CRNPattern, crn) {
+			effective = append(effective, binding)
+		}
+	}
+	return effective, nil
+}
@@ -0,0 +1,36 @@
+package confluentcloud
+
+import "testing"
+
+func TestAppliesTo(t *testing.T) {
+	const (
+		org     = "crn://confluent.cloud/organization=1"
+		env     = org + "/environment=env-1"
+		cluster = env + "/cloud-cluster=lkc-1"
+		topics  = cluster + "/kafka=lkc-1/topic="
+	)
+
+	tests := []struct {
+		pattern string
+		crn     string
+		want    bool
+	}{
+		{org, org, true},
+		{org, cluster, true},
+		{cluster, org, false},
+		{cluster, env, false},
+		{env, env + "-2", false},
+		{cluster, topics + "orders", true},
+		{topics + "orders-*", topics + "orders-eu", true},
+		{topics + "orders-*", topics + "payments", false},
+		{env + "/cloud-cluster=lkc-*", cluster, true},
+		{env + "/cloud-*", cluster, false},
+		{topics + "orders", topics + "orders-eu", false},
+	}
+
+	for _, tt := range tests {
+		if got := appliesTo(tt.pattern, tt.crn); got != tt.want {
+			t.Errorf("appliesTo(%s, %s) = %v, want %v", tt.pattern, tt.crn, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package confluentcloud_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/confluentcloudtest"
+)
+
+func TestRoleBindingScope(t *testing.T) {
+	org := confluentcloud.OrganizationScope(1)
+	env := org.WithEnvironment("env-1")
+
+	tests := []struct {
+		name    string
+		scope   confluentcloud.RoleBindingScope
+		wantCRN string
+		wantErr string
+	}{
+		{"organization", org, "crn://confluent.cloud/organization=1", ""},
+		{"environment", env, "crn://confluent.cloud/organization=1/environment=env-1", ""},
+		{"account", confluentcloud.AccountScope(confluentcloud.AccountMessage{ID: "env-1", OrganizationID: 1}),
+			"crn://confluent.cloud/organization=1/environment=env-1", ""},
+		{"cluster", env.WithCluster("lkc-1"),
+			"crn://confluent.cloud/organization=1/environment=env-1/cloud-cluster=lkc-1", ""},
+		{"topic prefix", env.WithCluster("lkc-1").WithTopic("orders-*"),
+			"crn://confluent.cloud/organization=1/environment=env-1/cloud-cluster=lkc-1/kafka=lkc-1/topic=orders-*", ""},
+		{"subject", env.WithSchemaRegistry("lsrc-1").WithSubject("orders-value"),
+			"crn://confluent.cloud/organization=1/environment=env-1/schema-registry=lsrc-1/subject=orders-value", ""},
+		{"no organization", confluentcloud.RoleBindingScope{EnvironmentID: "env-1"}, "", "organization"},
+		{"cluster without environment", org.WithCluster("lkc-1"), "", "environment"},
+		{"topic without cluster", env.WithTopic("orders"), "", "cluster"},
+		{"subject without registry", env.WithSubject("orders-value"), "", "schema_registry"},
+		{"cluster and registry", env.WithCluster("lkc-1").WithSchemaRegistry("lsrc-1"), "", "schema_registry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scope.Validate()
+			if tt.wantErr != "" {
+				if !isValidationError(err, tt.wantErr) {
+					t.Errorf("got %v, want a validation error for %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got %v, want no error", err)
+			}
+			if got := tt.scope.CRN(); got != tt.wantCRN {
+				t.Errorf("got CRN %s, want %s", got, tt.wantCRN)
+			}
+		})
+	}
+}
+
+func TestEffectiveRoleBindings(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	c := srv.NewClientWithAPIKey()
+
+	org := confluentcloud.OrganizationScope(confluentcloudtest.OrganizationID)
+	cluster := org.WithEnvironment(srv.DefaultEnvironmentID()).WithCluster("lkc-1")
+	principal := confluentcloud.UserPrincipal(1)
+	bindings := []struct {
+		role  string
+		scope confluentcloud.RoleBindingScope
+	}{
+		{"OrganizationAdmin", org},
+		{"CloudClusterAdmin", cluster},
+		{"DeveloperRead", cluster.WithTopic("orders-*")},
+		{"DeveloperWrite", org.WithEnvironment("env-other").WithCluster("lkc-2")},
+	}
+	for _, b := range bindings {
+		if _, err := c.CreateRoleBinding(principal, b.role, b.scope); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.CreateRoleBinding(confluentcloud.UserPrincipal(2), "CloudClusterAdmin", cluster); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		scope confluentcloud.RoleBindingScope
+		want  []string
+	}{
+		{"organization", org, []string{"OrganizationAdmin"}},
+		{"cluster", cluster, []string{"CloudClusterAdmin", "OrganizationAdmin"}},
+		{"matching topic", cluster.WithTopic("orders-eu"), []string{"CloudClusterAdmin", "DeveloperRead", "OrganizationAdmin"}},
+		{"other topic", cluster.WithTopic("payments"), []string{"CloudClusterAdmin", "OrganizationAdmin"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			effective, err := c.EffectiveRoleBindings(principal, tt.scope)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, b := range effective {
+				got = append(got, b.RoleName)
+			}
+			sort.Strings(got)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got roles %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("got roles %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+
+	if _, err := c.EffectiveRoleBindings(principal, org.WithCluster("lkc-1")); !isValidationError(err, "environment") {
+		t.Errorf("got %v for an invalid scope, want a validation error for environment", err)
+	}
+}
@@ -0,0 +1,360 @@
+package confluentcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	resty "github.com/go-resty/resty/v2"
+)
+
+const schemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+
+// LatestVersion addresses the latest version of a subject.
+const LatestVersion = -1
+
+// SchemaType is the format of a schema.
+type SchemaType string
+
+const (
+	SchemaTypeAvro     SchemaType = "AVRO"
+	SchemaTypeProtobuf SchemaType = "PROTOBUF"
+	SchemaTypeJSON     SchemaType = "JSON"
+)
+
+// SchemaReference refers to a schema imported by another schema, e.g. a
+// Protobuf import or an Avro named type defined under another subject.
+type SchemaReference struct {
+	// Name is the name the referencing schema uses, e.g. the import path.
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+// Schema is a schema registered under a subject. An empty SchemaType means
+// Avro.
+type Schema struct {
+	Subject    string            `json:"subject,omitempty"`
+	Version    int               `json:"version,omitempty"`
+	ID         int               `json:"id,omitempty"`
+	Schema     string            `json:"schema"`
+	SchemaType SchemaType        `json:"schemaType,omitempty"`
+	References []SchemaReference `json:"references,omitempty"`
+}
+
+type RegisterSchemaResponse struct {
+	ID int `json:"id"`
+}
+
+// CompatibilityResult is the outcome of a compatibility test. Messages
+// explains why a schema is incompatible.
+type CompatibilityResult struct {
+	IsCompatible bool     `json:"is_compatible"`
+	Messages     []string `json:"messages,omitempty"`
+}
+
+// SchemaRegistryClient manages the subjects, schemas and configuration of a
+// Schema Registry through its REST API at the registry's Endpoint. Create
+// it with Client.NewSchemaRegistryClient.
+type SchemaRegistryClient struct {
+	// RegistryID is the ID of the registry the client manages.
+	RegistryID string
+	// BaseURL is the registry's endpoint.
+	BaseURL *url.URL
+
+	// The resource services of the client. They refer to the client itself
+	// and can be replaced by fakes in tests.
+	Schemas SchemasService
+	Config  SchemaConfigService
+
+	client *Client
+	auth   Authenticator
+}
+
+// NewSchemaRegistryClient returns a client for the REST API of registry,
+// authenticated with key, an API key of that registry. Requests share the
+// retry policy, rate limiters, logger and HTTP client of c.
+func (c *Client) NewSchemaRegistryClient(registry *SchemaRegistry, key *APIKey) (*SchemaRegistryClient, error) {
+	if registry == nil || key == nil {
+		return nil, errors.New("new schema registry client: schema registry and API key are required")
+	}
+	if registry.Endpoint == "" {
+		return nil, fmt.Errorf("new schema registry client: schema registry %s has no endpoint", registry.ID)
+	}
+
+	base, err := url.Parse(strings.TrimSuffix(registry.Endpoint, "/") + "/")
+	if err != nil {
+		return nil, fmt.Errorf("new schema registry client: %w", err)
+	}
+
+	s := &SchemaRegistryClient{
+		RegistryID: registry.ID,
+		BaseURL:    base,
+		client:     c,
+		auth:       &APIKeyAuthenticator{Key: key.Key, Secret: key.Secret},
+	}
+	s.Schemas = s
+	s.Config = s
+	return s, nil
+}
+
+// NewRequest returns a request authenticated with the registry API key.
+func (s *SchemaRegistryClient) NewRequest(ctx context.Context) *resty.Request {
+	return s.client.NewRequest().
+		SetContext(withAuthenticator(ctx, s.auth)).
+		SetHeader("Accept", schemaRegistryContentType).
+		SetHeader("Content-Type", schemaRegistryContentType)
+}
+
+// url resolves a path against BaseURL; see resolve.
+func (s *SchemaRegistryClient) url(format string, args ...string) (string, error) {
+	return resolve(s.BaseURL, format, args...)
+}
+
+// ListSubjects returns the subjects of the registry. Soft-deleted subjects
+// are included if deleted is set.
+func (s *SchemaRegistryClient) ListSubjects(deleted bool) ([]string, error) {
+	return s.ListSubjectsContext(context.Background(), deleted)
+}
+
+func (s *SchemaRegistryClient) ListSubjectsContext(ctx context.Context, deleted bool) ([]string, error) {
+	u, err := s.url("subjects")
+	if err != nil {
+		return []string{}, err
+	}
+
+	subjects := []string{}
+	response, err := s.NewRequest(ctx).
+		SetQueryParam("deleted", strconv.FormatBool(deleted)).
+		SetResult(&subjects).
+		Get(u)
+
+	if err != nil {
+		return []string{}, err
+	}
+
+	if response.IsError() {
+		return []string{}, newAPIError("list subjects", response)
+	}
+
+	return subjects, nil
+}
+
+// ListVersions returns the versions registered under subject. Soft-deleted
+// versions are included if deleted is set.
+func (s *SchemaRegistryClient) ListVersions(subject string, deleted bool) ([]int, error) {
+	return s.ListVersionsContext(context.Background(), subject, deleted)
+}
+
+func (s *SchemaRegistryClient) ListVersionsContext(ctx context.Context, subject string, deleted bool) ([]int, error) {
+	u, err := s.url("subjects/%s/versions", subject)
+	if err != nil {
+		return []int{}, err
+	}
+
+	versions := []int{}
+	response, err := s.NewRequest(ctx).
+		SetQueryParam("deleted", strconv.FormatBool(deleted)).
+		SetResult(&versions).
+		Get(u)
+
+	if err != nil {
+		return []int{}, err
+	}
+
+	if response.IsError() {
+		return []int{}, newAPIError("list versions", response)
+	}
+
+	return versions, nil
+}
+
+// GetSchema returns a version of subject, or its latest version if version
+// is LatestVersion.
+func (s *SchemaRegistryClient) GetSchema(subject string, version int) (*Schema, error) {
+	return s.GetSchemaContext(context.Background(), subject, version)
+}
+
+func (s *SchemaRegistryClient) GetSchemaContext(ctx context.Context, subject string, version int) (*Schema, error) {
+	u, err := s.url("subjects/%s/versions/%s", subject, strconv.Itoa(version))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetResult(&Schema{}).
+		Get(u)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, newAPIError("get schema", response)
+	}
+
+	return response.Result().(*Schema), nil
+}
+
+// GetSchemaByID returns the schema with the given global ID. Subject and
+// Version are not set, as a schema can be registered under several
+// subjects.
+func (s *SchemaRegistryClient) GetSchemaByID(id int) (*Schema, error) {
+	return s.GetSchemaByIDContext(context.Background(), id)
+}
+
+func (s *SchemaRegistryClient) GetSchemaByIDContext(ctx context.Context, id int) (*Schema, error) {
+	u, err := s.url("schemas/ids/%s", strconv.Itoa(id))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetResult(&Schema{}).
+		Get(u)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, newAPIError("get schema by id", response)
+	}
+
+	schema := response.Result().(*Schema)
+	schema.ID = id
+	return schema, nil
+}
+
+// RegisterSchema registers schema under subject and returns its global ID.
+// Registering a schema that the subject already has returns the existing
+// ID. Only the Schema, SchemaType and References fields are sent.
+func (s *SchemaRegistryClient) RegisterSchema(subject string, schema *Schema) (int, error) {
+	return s.RegisterSchemaContext(context.Background(), subject, schema)
+}
+
+func (s *SchemaRegistryClient) RegisterSchemaContext(ctx context.Context, subject string, schema *Schema) (int, error) {
+	if schema == nil {
+		return 0, &ValidationError{Field: "schema", Reason: "must not be nil"}
+	}
+
+	u, err := s.url("subjects/%s/versions", subject)
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetBody(schemaBody(schema)).
+		SetResult(&RegisterSchemaResponse{}).
+		Post(u)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if response.IsError() {
+		return 0, newAPIError("register schema", response)
+	}
+
+	return response.Result().(*RegisterSchemaResponse).ID, nil
+}
+
+// DeleteSchemaVersion deletes a version of subject. A soft delete hides the
+// version but keeps its ID reserved; a permanent delete removes it and is
+// only allowed after a soft delete.
+func (s *SchemaRegistryClient) DeleteSchemaVersion(subject string, version int, permanent bool) error {
+	return s.DeleteSchemaVersionContext(context.Background(), subject, version, permanent)
+}
+
+func (s *SchemaRegistryClient) DeleteSchemaVersionContext(ctx context.Context, subject string, version int, permanent bool) error {
+	u, err := s.url("subjects/%s/versions/%s", subject, strconv.Itoa(version))
+	if err != nil {
+		return err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetQueryParam("permanent", strconv.FormatBool(permanent)).
+		Delete(u)
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError("delete schema version", response)
+	}
+
+	return nil
+}
+
+// DeleteSubject deletes all versions of subject and returns them. As with
+// DeleteSchemaVersion, a permanent delete must follow a soft delete.
+func (s *SchemaRegistryClient) DeleteSubject(subject string, permanent bool) ([]int, error) {
+	return s.DeleteSubjectContext(context.Background(), subject, permanent)
+}
+
+func (s *SchemaRegistryClient) DeleteSubjectContext(ctx context.Context, subject string, permanent bool) ([]int, error) {
+	u, err := s.url("subjects/%s", subject)
+	if err != nil {
+		return []int{}, err
+	}
+
+	versions := []int{}
+	response, err := s.NewRequest(ctx).
+		SetQueryParam("permanent", strconv.FormatBool(permanent)).
+		SetResult(&versions).
+		Delete(u)
+
+	if err != nil {
+		return []int{}, err
+	}
+
+	if response.IsError() {
+		return []int{}, newAPIError("delete subject", response)
+	}
+
+	return versions, nil
+}
+
+// TestCompatibility tests whether schema is compatible with a version of
+// subject under the subject's compatibility level. Pass LatestVersion to
+// test against the latest version.
+func (s *SchemaRegistryClient) TestCompatibility(subject string, version int, schema *Schema) (*CompatibilityResult, error) {
+	return s.TestCompatibilityContext(context.Background(), subject, version, schema)
+}
+
+func (s *SchemaRegistryClient) TestCompatibilityContext(ctx context.Context, subject string, version int, schema *Schema) (*CompatibilityResult, error) {
+	if schema == nil {
+		return nil, &ValidationError{Field: "schema", Reason: "must not be nil"}
+	}
+
+	u, err := s.url("compatibility/subjects/%s/versions/%s", subject, strconv.Itoa(version))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetQueryParam("verbose", "true").
+		SetBody(schemaBody(schema)).
+		SetResult(&CompatibilityResult{}).
+		Post(u)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, newAPIError("test compatibility", response)
+	}
+
+	return response.Result().(*CompatibilityResult), nil
+}
+
+// schemaBody returns the fields of schema that the registry accepts in
+// register and compatibility requests.
+func schemaBody(schema *Schema) *Schema {
+	return &Schema{Schema: schema.Schema, SchemaType: schema.SchemaType, References: schema.References}
+}
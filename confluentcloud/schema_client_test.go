@@ -0,0 +1,40 @@
+package confluentcloud_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+func TestNilSchemaIsRejected(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer srv.Close()
+
+	c := confluentcloud.NewClientWithAPIKey("key", "secret")
+	registry, err := c.NewSchemaRegistryClient(
+		&confluentcloud.SchemaRegistry{ID: "lsrc-1", Endpoint: srv.URL},
+		&confluentcloud.APIKey{Key: "key", Secret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := registry.RegisterSchema("orders-value", nil); !isValidationError(err, "schema") {
+		t.Errorf("RegisterSchema: got %v, want a validation error for schema", err)
+	}
+	if _, err := registry.TestCompatibility("orders-value", confluentcloud.LatestVersion, nil); !isValidationError(err, "schema") {
+		t.Errorf("TestCompatibility: got %v, want a validation error for schema", err)
+	}
+	if requests != 0 {
+		t.Errorf("got %d requests, want none", requests)
+	}
+}
+
+func isValidationError(err error, field string) bool {
+	verr, ok := err.(*confluentcloud.ValidationError)
+	return ok && verr.Field == field
+}
@@ -0,0 +1,276 @@
+package confluentcloud
+
+import (
+	"context"
+	"fmt"
+)
+
+// CompatibilityLevel tells which versions of a subject a new schema must be
+// compatible with, and how.
+type CompatibilityLevel string
+
+const (
+	// CompatibilityNone disables compatibility checks.
+	CompatibilityNone CompatibilityLevel = "NONE"
+	// CompatibilityBackward requires that consumers using the new schema
+	// can read data written with the latest version.
+	CompatibilityBackward CompatibilityLevel = "BACKWARD"
+	// CompatibilityBackwardTransitive extends BACKWARD to all versions.
+	CompatibilityBackwardTransitive CompatibilityLevel = "BACKWARD_TRANSITIVE"
+	// CompatibilityForward requires that consumers using the latest
+	// version can read data written with the new schema.
+	CompatibilityForward CompatibilityLevel = "FORWARD"
+	// CompatibilityForwardTransitive extends FORWARD to all versions.
+	CompatibilityForwardTransitive CompatibilityLevel = "FORWARD_TRANSITIVE"
+	// CompatibilityFull requires both BACKWARD and FORWARD.
+	CompatibilityFull CompatibilityLevel = "FULL"
+	// CompatibilityFullTransitive extends FULL to all versions.
+	CompatibilityFullTransitive CompatibilityLevel = "FULL_TRANSITIVE"
+)
+
+func (l CompatibilityLevel) valid() bool {
+	switch l {
+	case CompatibilityNone, CompatibilityBackward, CompatibilityBackwardTransitive,
+		CompatibilityForward, CompatibilityForwardTransitive, CompatibilityFull, CompatibilityFullTransitive:
+		return true
+	}
+	return false
+}
+
+// SchemaRegistryMode controls which writes a registry or subject accepts.
+type SchemaRegistryMode string
+
+const (
+	// ModeReadWrite accepts new schemas. It is the default.
+	ModeReadWrite SchemaRegistryMode = "READWRITE"
+	// ModeReadOnly rejects new schemas and deletes.
+	ModeReadOnly SchemaRegistryMode = "READONLY"
+	// ModeImport accepts schemas with given IDs and versions, e.g. to
+	// migrate from another registry. It can only be set on an empty
+	// registry or subject.
+	ModeImport SchemaRegistryMode = "IMPORT"
+)
+
+func (m SchemaRegistryMode) valid() bool {
+	return m == ModeReadWrite || m == ModeReadOnly || m == ModeImport
+}
+
+// CompatibilityConfig is the compatibility config of a registry or subject.
+// The registry reports the level as compatibilityLevel but expects it as
+// compatibility.
+type CompatibilityConfig struct {
+	CompatibilityLevel CompatibilityLevel `json:"compatibilityLevel,omitempty"`
+	Compatibility      CompatibilityLevel `json:"compatibility,omitempty"`
+}
+
+type ModeConfig struct {
+	Mode SchemaRegistryMode `json:"mode"`
+}
+
+// GetCompatibility returns the global compatibility level of the registry.
+func (s *SchemaRegistryClient) GetCompatibility() (CompatibilityLevel, error) {
+	return s.GetCompatibilityContext(context.Background())
+}
+
+func (s *SchemaRegistryClient) GetCompatibilityContext(ctx context.Context) (CompatibilityLevel, error) {
+	return s.getCompatibility(ctx, "get compatibility", "config")
+}
+
+// SetCompatibility sets the global compatibility level of the registry,
+// which applies to the subjects without a level of their own.
+func (s *SchemaRegistryClient) SetCompatibility(level CompatibilityLevel) error {
+	return s.SetCompatibilityContext(context.Background(), level)
+}
+
+func (s *SchemaRegistryClient) SetCompatibilityContext(ctx context.Context, level CompatibilityLevel) error {
+	return s.setCompatibility(ctx, "set compatibility", level, "config")
+}
+
+// GetSubjectCompatibility returns the compatibility level that applies to
+// subject: its own, or the global level if it has none.
+func (s *SchemaRegistryClient) GetSubjectCompatibility(subject string) (CompatibilityLevel, error) {
+	return s.GetSubjectCompatibilityContext(context.Background(), subject)
+}
+
+func (s *SchemaRegistryClient) GetSubjectCompatibilityContext(ctx context.Context, subject string) (CompatibilityLevel, error) {
+	return s.getCompatibility(ctx, "get subject compatibility", "config/%s?defaultToGlobal=true", subject)
+}
+
+// SetSubjectCompatibility sets the compatibility level of subject,
+// overriding the global level.
+func (s *SchemaRegistryClient) SetSubjectCompatibility(subject string, level CompatibilityLevel) error {
+	return s.SetSubjectCompatibilityContext(context.Background(), subject, level)
+}
+
+func (s *SchemaRegistryClient) SetSubjectCompatibilityContext(ctx context.Context, subject string, level CompatibilityLevel) error {
+	return s.setCompatibility(ctx, "set subject compatibility", level, "config/%s", subject)
+}
+
+// DeleteSubjectCompatibility removes the compatibility level of subject, so
+// that the global level applies again.
+func (s *SchemaRegistryClient) DeleteSubjectCompatibility(subject string) error {
+	return s.DeleteSubjectCompatibilityContext(context.Background(), subject)
+}
+
+func (s *SchemaRegistryClient) DeleteSubjectCompatibilityContext(ctx context.Context, subject string) error {
+	return s.delete(ctx, "delete subject compatibility", "config/%s", subject)
+}
+
+// GetMode returns the global mode of the registry.
+func (s *SchemaRegistryClient) GetMode() (SchemaRegistryMode, error) {
+	return s.GetModeContext(context.Background())
+}
+
+func (s *SchemaRegistryClient) GetModeContext(ctx context.Context) (SchemaRegistryMode, error) {
+	return s.getMode(ctx, "get mode", "mode")
+}
+
+// SetMode sets the global mode of the registry.
+func (s *SchemaRegistryClient) SetMode(mode SchemaRegistryMode) error {
+	return s.SetModeContext(context.Background(), mode)
+}
+
+func (s *SchemaRegistryClient) SetModeContext(ctx context.Context, mode SchemaRegistryMode) error {
+	return s.setMode(ctx, "set mode", mode, "mode")
+}
+
+// GetSubjectMode returns the mode that applies to subject: its own, or the
+// global mode if it has none.
+func (s *SchemaRegistryClient) GetSubjectMode(subject string) (SchemaRegistryMode, error) {
+	return s.GetSubjectModeContext(context.Background(), subject)
+}
+
+func (s *SchemaRegistryClient) GetSubjectModeContext(ctx context.Context, subject string) (SchemaRegistryMode, error) {
+	return s.getMode(ctx, "get subject mode", "mode/%s?defaultToGlobal=true", subject)
+}
+
+// SetSubjectMode sets the mode of subject, overriding the global mode.
+func (s *SchemaRegistryClient) SetSubjectMode(subject string, mode SchemaRegistryMode) error {
+	return s.SetSubjectModeContext(context.Background(), subject, mode)
+}
+
+func (s *SchemaRegistryClient) SetSubjectModeContext(ctx context.Context, subject string, mode SchemaRegistryMode) error {
+	return s.setMode(ctx, "set subject mode", mode, "mode/%s", subject)
+}
+
+// DeleteSubjectMode removes the mode of subject, so that the global mode
+// applies again.
+func (s *SchemaRegistryClient) DeleteSubjectMode(subject string) error {
+	return s.DeleteSubjectModeContext(context.Background(), subject)
+}
+
+func (s *SchemaRegistryClient) DeleteSubjectModeContext(ctx context.Context, subject string) error {
+	return s.delete(ctx, "delete subject mode", "mode/%s", subject)
+}
+
+func (s *SchemaRegistryClient) getCompatibility(ctx context.Context, op, format string, args ...string) (CompatibilityLevel, error) {
+	u, err := s.url(format, args...)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetResult(&CompatibilityConfig{}).
+		Get(u)
+
+	if err != nil {
+		return "", err
+	}
+
+	if response.IsError() {
+		return "", newAPIError(op, response)
+	}
+
+	return response.Result().(*CompatibilityConfig).CompatibilityLevel, nil
+}
+
+func (s *SchemaRegistryClient) setCompatibility(ctx context.Context, op string, level CompatibilityLevel, format string, args ...string) error {
+	if !level.valid() {
+		return &ValidationError{Field: "compatibility", Reason: fmt.Sprintf("unknown compatibility level %q", level)}
+	}
+
+	u, err := s.url(format, args...)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetBody(&CompatibilityConfig{Compatibility: level}).
+		Put(u)
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError(op, response)
+	}
+
+	return nil
+}
+
+func (s *SchemaRegistryClient) getMode(ctx context.Context, op, format string, args ...string) (SchemaRegistryMode, error) {
+	u, err := s.url(format, args...)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetResult(&ModeConfig{}).
+		Get(u)
+
+	if err != nil {
+		return "", err
+	}
+
+	if response.IsError() {
+		return "", newAPIError(op, response)
+	}
+
+	return response.Result().(*ModeConfig).Mode, nil
+}
+
+func (s *SchemaRegistryClient) setMode(ctx context.Context, op string, mode SchemaRegistryMode, format string, args ...string) error {
+	if !mode.valid() {
+		return &ValidationError{Field: "mode", Reason: fmt.Sprintf("unknown mode %q", mode)}
+	}
+
+	u, err := s.url(format, args...)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.NewRequest(ctx).
+		SetBody(&ModeConfig{Mode: mode}).
+		Put(u)
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError(op, response)
+	}
+
+	return nil
+}
+
+func (s *SchemaRegistryClient) delete(ctx context.Context, op, format string, args ...string) error {
+	u, err := s.url(format, args...)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.NewRequest(ctx).
+		Delete(u)
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError(op, response)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package confluentcloud_test
+
+import (
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/confluentcloudtest"
+)
+
+// newSchemaRegistryClient enables the Schema Registry of the default
+// environment of srv and returns a client for it.
+func newSchemaRegistryClient(t *testing.T, srv *confluentcloudtest.Server) *confluentcloud.SchemaRegistryClient {
+	t.Helper()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+
+	_, err := c.CreateCluster(confluentcloud.ClusterCreateConfig{
+		Name:            "orders",
+		AccountID:       env,
+		Region:          "us-west-2",
+		ServiceProvider: confluentcloud.ServiceProviderAWS,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err := c.CreateSchemaRegistry(env, "us", "aws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := c.CreateAPIKey(&confluentcloud.ApiKeyCreateRequest{
+		AccountID:       env,
+		LogicalClusters: []confluentcloud.LogicalCluster{{ID: registry.ID}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := c.NewSchemaRegistryClient(registry, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSchemaCompatibilityConfig(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	s := newSchemaRegistryClient(t, srv)
+
+	check := func(got confluentcloud.CompatibilityLevel, err error, want confluentcloud.CompatibilityLevel) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+
+	if err := s.SetCompatibility(confluentcloud.CompatibilityFull); err != nil {
+		t.Fatal(err)
+	}
+	level, err := s.GetCompatibility()
+	check(level, err, confluentcloud.CompatibilityFull)
+
+	// A subject without a level of its own falls back to the global one.
+	level, err = s.GetSubjectCompatibility("orders-value")
+	check(level, err, confluentcloud.CompatibilityFull)
+
+	if err := s.SetSubjectCompatibility("orders-value", confluentcloud.CompatibilityNone); err != nil {
+		t.Fatal(err)
+	}
+	level, err = s.GetSubjectCompatibility("orders-value")
+	check(level, err, confluentcloud.CompatibilityNone)
+	level, err = s.GetCompatibility()
+	check(level, err, confluentcloud.CompatibilityFull)
+
+	if err := s.DeleteSubjectCompatibility("orders-value"); err != nil {
+		t.Fatal(err)
+	}
+	level, err = s.GetSubjectCompatibility("orders-value")
+	check(level, err, confluentcloud.CompatibilityFull)
+	if err := s.DeleteSubjectCompatibility("orders-value"); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v deleting a missing level, want not found", err)
+	}
+
+	if err := s.SetCompatibility("STRICT"); !isValidationError(err, "compatibility") {
+		t.Errorf("got %v for an unknown level, want a validation error for compatibility", err)
+	}
+}
+
+func TestSchemaModeConfig(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	s := newSchemaRegistryClient(t, srv)
+
+	check := func(got confluentcloud.SchemaRegistryMode, err error, want confluentcloud.SchemaRegistryMode) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+
+	mode, err := s.GetMode()
+	check(mode, err, confluentcloud.ModeReadWrite)
+
+	if err := s.SetSubjectMode("orders-value", confluentcloud.ModeReadOnly); err != nil {
+		t.Fatal(err)
+	}
+	mode, err = s.GetSubjectMode("orders-value")
+	check(mode, err, confluentcloud.ModeReadOnly)
+	mode, err = s.GetSubjectMode("payments-value")
+	check(mode, err, confluentcloud.ModeReadWrite)
+
+	if err := s.SetMode(confluentcloud.ModeImport); err != nil {
+		t.Fatal(err)
+	}
+	mode, err = s.GetSubjectMode("payments-value")
+	check(mode, err, confluentcloud.ModeImport)
+
+	if err := s.DeleteSubjectMode("orders-value"); err != nil {
+		t.Fatal(err)
+	}
+	mode, err = s.GetSubjectMode("orders-value")
+	check(mode, err, confluentcloud.ModeImport)
+
+	if err := s.SetMode("WRITEONLY"); !isValidationError(err, "mode") {
+		t.Errorf("got %v for an unknown mode, want a validation error for mode", err)
+	}
+}
@@ -1,12 +1,15 @@
 package confluentcloud
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 )
 
-const schemaRegistryApiResource = "schema_registries?account_id=%s"
+// schemaRegistryName is the name CreateSchemaRegistry gives the registry it
+// enables.
 const schemaRegistryName = "account schema-registry"
 
 type SchemaRegistry struct {
@@ -21,15 +24,16 @@ type SchemaRegistry struct {
 	AccountID         string    `json:"account_id"`
 	OrganizationID    int       `json:"organization_id"`
 	MaxSchemas        int       `json:"max_schemas"`
+	Package           string    `json:"package,omitempty"`
 }
 
 type SchemaRegistryResponse struct {
-	Error interface{}         `json:"error"`
+	Error    interface{}      `json:"error"`
 	Clusters []SchemaRegistry `json:"clusters"`
 }
 
 type SchemaRegistryCreateResponse struct {
-	Error interface{}      `json:"error"`
+	Error   interface{}    `json:"error"`
 	Cluster SchemaRegistry `json:"cluster"`
 }
 
@@ -38,85 +42,262 @@ type SchemaRegistryCreateRequest struct {
 }
 
 type SchemaRegistryRequest struct {
-	AccountID           string `json:"account_id"`
-	KafkaClusterID      string `json:"kafka_cluster_id"`
-	Location            string `json:"location"`
-	Name                string `json:"name"`
-	ServiceProvider     string `json:"service_provider"`
+	AccountID       string `json:"account_id"`
+	KafkaClusterID  string `json:"kafka_cluster_id"`
+	Location        string `json:"location"`
+	Name            string `json:"name"`
+	ServiceProvider string `json:"service_provider"`
+	Package         string `json:"package,omitempty"`
 }
 
-func (c *Client) GetSchemaRegistry(id string) (*SchemaRegistry, error) {
-	rel, err := url.Parse(fmt.Sprintf(schemaRegistryApiResource, id))
+// SchemaRegistryLocation is the geography a registry runs in.
+type SchemaRegistryLocation string
+
+const (
+	SchemaRegistryLocationUS   SchemaRegistryLocation = "us"
+	SchemaRegistryLocationEU   SchemaRegistryLocation = "eu"
+	SchemaRegistryLocationAPAC SchemaRegistryLocation = "apac"
+)
+
+// normalize returns l in the lower case the API uses, so that e.g. "US" is
+// accepted as well.
+func (l SchemaRegistryLocation) normalize() SchemaRegistryLocation {
+	return SchemaRegistryLocation(strings.ToLower(string(l)))
+}
+
+func (l SchemaRegistryLocation) valid() bool {
+	return l == SchemaRegistryLocationUS || l == SchemaRegistryLocationEU || l == SchemaRegistryLocationAPAC
+}
+
+// SchemaRegistryPackage is the feature and pricing package of a registry.
+type SchemaRegistryPackage string
+
+const (
+	SchemaRegistryPackageEssentials SchemaRegistryPackage = "ESSENTIALS"
+	SchemaRegistryPackageAdvanced   SchemaRegistryPackage = "ADVANCED"
+)
+
+// normalize returns p in the upper case the API uses.
+func (p SchemaRegistryPackage) normalize() SchemaRegistryPackage {
+	return SchemaRegistryPackage(strings.ToUpper(string(p)))
+}
+
+func (p SchemaRegistryPackage) valid() bool {
+	return p == SchemaRegistryPackageEssentials || p == SchemaRegistryPackageAdvanced
+}
+
+// SchemaRegistryCreateConfig describes a new schema registry. An empty
+// Package leaves the choice to the API.
+type SchemaRegistryCreateConfig struct {
+	AccountID       string
+	Name            string
+	KafkaClusterID  string
+	Location        SchemaRegistryLocation
+	ServiceProvider ServiceProvider
+	Package         SchemaRegistryPackage
+}
+
+// Validate checks the config for mistakes the API would reject, so that they
+// surface before CreateSchemaRegistryWithConfig sends the request. Location,
+// ServiceProvider and Package are compared case-insensitively.
+func (r SchemaRegistryCreateConfig) Validate() error {
+	r = r.normalize()
+	if r.AccountID == "" {
+		return &ValidationError{Field: "account_id", Reason: "must not be empty"}
+	}
+	if r.Name == "" {
+		return &ValidationError{Field: "name", Reason: "must not be empty"}
+	}
+	if !r.Location.valid() {
+		return &ValidationError{Field: "location", Reason: fmt.Sprintf("unknown location %q", r.Location)}
+	}
+	if !r.ServiceProvider.valid() {
+		return &ValidationError{Field: "service_provider", Reason: fmt.Sprintf("unknown service provider %q", r.ServiceProvider)}
+	}
+	if r.Package != "" && !r.Package.valid() {
+		return &ValidationError{Field: "package", Reason: fmt.Sprintf("unknown package %q", r.Package)}
+	}
+	return nil
+}
+
+// normalize returns a copy of r with Location, ServiceProvider and Package in
+// the case the API expects.
+func (r SchemaRegistryCreateConfig) normalize() SchemaRegistryCreateConfig {
+	r.Location = r.Location.normalize()
+	r.ServiceProvider = r.ServiceProvider.normalize()
+	r.Package = r.Package.normalize()
+	return r
+}
+
+// SchemaRegistryNotFoundError is returned when an environment has no schema
+// registry. IsNotFound reports true for it.
+type SchemaRegistryNotFoundError struct {
+	AccountID string
+}
+
+func (e *SchemaRegistryNotFoundError) Error() string {
+	return fmt.Sprintf("environment %s has no schema registry", e.AccountID)
+}
+
+func (c *Client) ListSchemaRegistries(accountID string) ([]SchemaRegistry, error) {
+	return c.ListSchemaRegistriesContext(context.Background(), accountID)
+}
+
+func (c *Client) ListSchemaRegistriesContext(ctx context.Context, accountID string) ([]SchemaRegistry, error) {
+	rel, err := url.Parse("schema_registries")
 	if err != nil {
-		return nil, err
+		return []SchemaRegistry{}, err
 	}
 
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetQueryParam("account_id", accountID).
 		SetResult(&SchemaRegistryResponse{}).
-		SetError(&ErrorResponse{}).
 		Get(u.String())
 
 	if err != nil {
-		return nil, err
+		return []SchemaRegistry{}, err
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("Get schema registry error: %s", response.Error().(*ErrorResponse).Error.Message)
+		return []SchemaRegistry{}, newAPIError("list schema registries", response)
 	}
 
-	schema_clusters := response.Result().(*SchemaRegistryResponse).Clusters
+	return response.Result().(*SchemaRegistryResponse).Clusters, nil
+}
 
-	for i:= 0; i < len(schema_clusters); i++ {
-		if schema_clusters[i].Name == schemaRegistryName {
-			return &schema_clusters[i], nil
+// GetSchemaRegistry returns the schema registry of an environment, or a
+// *SchemaRegistryNotFoundError if it has none. If the environment has
+// several, the one created by CreateSchemaRegistry is preferred, otherwise
+// the first one listed is returned; use ListSchemaRegistries and
+// GetSchemaRegistryByID to choose.
+//
+// Earlier versions returned nil and no error when the environment had no
+// registry named as by CreateSchemaRegistry. Check the error with IsNotFound
+// instead of comparing the registry with nil.
+func (c *Client) GetSchemaRegistry(accountID string) (*SchemaRegistry, error) {
+	return c.GetSchemaRegistryContext(context.Background(), accountID)
+}
+
+func (c *Client) GetSchemaRegistryContext(ctx context.Context, accountID string) (*SchemaRegistry, error) {
+	registries, err := c.ListSchemaRegistriesContext(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(registries) == 0 {
+		return nil, &SchemaRegistryNotFoundError{AccountID: accountID}
+	}
+
+	for i := range registries {
+		if registries[i].Name == schemaRegistryName {
+			return &registries[i], nil
 		}
 	}
+	return &registries[0], nil
+}
 
-	return nil, nil
+func (c *Client) GetSchemaRegistryByID(id, accountID string) (*SchemaRegistry, error) {
+	return c.GetSchemaRegistryByIDContext(context.Background(), id, accountID)
 }
 
-func (c *Client) CreateSchemaRegistry(accountID string, location string, serviceProvider string) (*SchemaRegistry, error) {
-	rel, err := url.Parse(fmt.Sprintf(schemaRegistryApiResource, accountID))
+func (c *Client) GetSchemaRegistryByIDContext(ctx context.Context, id, accountID string) (*SchemaRegistry, error) {
+	rel, err := url.Parse("schema_registries/" + url.PathEscape(id))
 	if err != nil {
 		return nil, err
 	}
 
-	kafka_clusters, err := c.ListClusters(accountID)
+	u := c.BaseURL.ResolveReference(rel)
+
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetQueryParam("account_id", accountID).
+		SetResult(&SchemaRegistryCreateResponse{}).
+		Get(u.String())
 
 	if err != nil {
 		return nil, err
 	}
 
-	if len(kafka_clusters) == 0 {
-		return nil, fmt.Errorf("No cluster found. Cannot enable schema registry. Environment: %s", accountID)
+	if response.IsError() {
+		return nil, newAPIError("get schema registry", response)
 	}
 
-	schema_cluster, err := c.GetSchemaRegistry(accountID)
+	return &response.Result().(*SchemaRegistryCreateResponse).Cluster, nil
+}
+
+// CreateSchemaRegistry enables the schema registry of an environment that
+// has at least one cluster, and returns it. If the environment already has
+// a registry, that one is returned.
+func (c *Client) CreateSchemaRegistry(accountID string, location string, serviceProvider string) (*SchemaRegistry, error) {
+	return c.CreateSchemaRegistryContext(context.Background(), accountID, location, serviceProvider)
+}
+
+func (c *Client) CreateSchemaRegistryContext(ctx context.Context, accountID string, location string, serviceProvider string) (*SchemaRegistry, error) {
+	kafka_clusters, err := c.ListClustersContext(ctx, accountID)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if schema_cluster != nil {
+	if len(kafka_clusters) == 0 {
+		return nil, fmt.Errorf("No cluster found. Cannot enable schema registry. Environment: %s", accountID)
+	}
+
+	schema_cluster, err := c.GetSchemaRegistryContext(ctx, accountID)
+
+	if err == nil {
 		return schema_cluster, nil
 	}
 
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	return c.CreateSchemaRegistryWithConfigContext(ctx, SchemaRegistryCreateConfig{
+		AccountID:       accountID,
+		Name:            schemaRegistryName,
+		KafkaClusterID:  kafka_clusters[0].ID,
+		Location:        SchemaRegistryLocation(location),
+		ServiceProvider: ServiceProvider(serviceProvider),
+	})
+}
+
+// CreateSchemaRegistryWithConfig creates a schema registry as described by
+// config.
+func (c *Client) CreateSchemaRegistryWithConfig(config SchemaRegistryCreateConfig) (*SchemaRegistry, error) {
+	return c.CreateSchemaRegistryWithConfigContext(context.Background(), config)
+}
+
+func (c *Client) CreateSchemaRegistryWithConfigContext(ctx context.Context, config SchemaRegistryCreateConfig) (*SchemaRegistry, error) {
+	config = config.normalize()
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	rel, err := url.Parse("schema_registries")
+	if err != nil {
+		return nil, err
+	}
+
 	u := c.BaseURL.ResolveReference(rel)
 
 	request := SchemaRegistryRequest{
-		KafkaClusterID: "",
-		Name: schemaRegistryName,
-		AccountID: accountID,
-		Location: location,
-		ServiceProvider: serviceProvider,
+		AccountID:       config.AccountID,
+		KafkaClusterID:  config.KafkaClusterID,
+		Location:        string(config.Location),
+		Name:            config.Name,
+		ServiceProvider: string(config.ServiceProvider),
+		Package:         string(config.Package),
 	}
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetQueryParam("account_id", config.AccountID).
 		SetBody(&SchemaRegistryCreateRequest{Config: request}).
 		SetResult(&SchemaRegistryCreateResponse{}).
-		SetError(&ErrorResponse{}).
 		Post(u.String())
 
 	if err != nil {
@@ -124,14 +305,36 @@ func (c *Client) CreateSchemaRegistry(accountID string, location string, service
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("Schema registry: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("create schema registry", response)
+	}
+
+	return &response.Result().(*SchemaRegistryCreateResponse).Cluster, nil
+}
+
+func (c *Client) DeleteSchemaRegistry(id, accountID string) error {
+	return c.DeleteSchemaRegistryContext(context.Background(), id, accountID)
+}
+
+func (c *Client) DeleteSchemaRegistryContext(ctx context.Context, id, accountID string) error {
+	rel, err := url.Parse("schema_registries/" + url.PathEscape(id))
+	if err != nil {
+		return err
 	}
 
-	cluster := &response.Result().(*SchemaRegistryCreateResponse).Cluster
+	u := c.BaseURL.ResolveReference(rel)
+
+	response, err := c.NewRequest().
+		SetContext(ctx).
+		SetQueryParam("account_id", accountID).
+		Delete(u.String())
 
-	if cluster == nil {
-		return nil, fmt.Errorf("Schema registry not created. Environment: %s", accountID)
+	if err != nil {
+		return err
 	}
 
-	return cluster, nil
-}
\ No newline at end of file
+	if response.IsError() {
+		return newAPIError("delete schema registry", response)
+	}
+
+	return nil
+}
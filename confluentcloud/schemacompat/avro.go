@@ -0,0 +1,357 @@
+package schemacompat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// avroSchema is a node of a parsed Avro schema. Named types are shared, so
+// recursive schemas form cycles.
+type avroSchema struct {
+	// kind is a primitive type name, record, enum, array, map, fixed,
+	// union, or ref for a named type defined outside the schema, e.g. in a
+	// schema reference.
+	kind string
+	// name is the full name of a named type.
+	name    string
+	aliases []string
+
+	fields   []avroField
+	symbols  []string
+	fallback bool // whether an enum has a default symbol
+	items    *avroSchema
+	values   *avroSchema
+	size     int
+	branches []*avroSchema
+}
+
+type avroField struct {
+	name       string
+	aliases    []string
+	typ        *avroSchema
+	hasDefault bool
+}
+
+var avroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true,
+	"float": true, "double": true, "bytes": true, "string": true,
+}
+
+// avroPromotions lists the writer types each reader type can read besides
+// its own.
+var avroPromotions = map[string][]string{
+	"long":   {"int"},
+	"float":  {"int", "long"},
+	"double": {"int", "long", "float"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+func (s *avroSchema) schemaType() confluentcloud.SchemaType {
+	return confluentcloud.SchemaTypeAvro
+}
+
+func (s *avroSchema) String() string {
+	if s.name != "" {
+		return fmt.Sprintf("%s %s", s.kind, s.name)
+	}
+	return s.kind
+}
+
+func parseAvro(text string) (*avroSchema, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(text), &v); err != nil {
+		return nil, err
+	}
+	p := &avroParser{names: make(map[string]*avroSchema)}
+	return p.parse(v, "")
+}
+
+type avroParser struct {
+	names map[string]*avroSchema
+}
+
+func (p *avroParser) parse(v interface{}, namespace string) (*avroSchema, error) {
+	switch v := v.(type) {
+	case string:
+		if avroPrimitives[v] {
+			return &avroSchema{kind: v}, nil
+		}
+		name := fullName(v, namespace)
+		if s, ok := p.names[name]; ok {
+			return s, nil
+		}
+		if s, ok := p.names[v]; ok {
+			return s, nil
+		}
+		return &avroSchema{kind: "ref", name: name}, nil
+	case []interface{}:
+		union := &avroSchema{kind: "union"}
+		for _, branch := range v {
+			s, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.branches = append(union.branches, s)
+		}
+		return union, nil
+	case map[string]interface{}:
+		return p.parseObject(v, namespace)
+	}
+	return nil, fmt.Errorf("invalid schema %v", v)
+}
+
+func (p *avroParser) parseObject(v map[string]interface{}, namespace string) (*avroSchema, error) {
+	typ, ok := v["type"].(string)
+	if !ok {
+		if v["type"] == nil {
+			return nil, fmt.Errorf("schema %v has no type", v)
+		}
+		return p.parse(v["type"], namespace)
+	}
+
+	switch typ {
+	case "record", "error", "enum", "fixed":
+		return p.parseNamed(v, typ, namespace)
+	case "array":
+		items, err := p.parse(v["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroSchema{kind: "array", items: items}, nil
+	case "map":
+		values, err := p.parse(v["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroSchema{kind: "map", values: values}, nil
+	}
+	// A primitive with attributes such as logicalType, or a reference.
+	return p.parse(typ, namespace)
+}
+
+func (p *avroParser) parseNamed(v map[string]interface{}, typ, namespace string) (*avroSchema, error) {
+	name, _ := v["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%s has no name", typ)
+	}
+	if ns, ok := v["namespace"].(string); ok && !strings.Contains(name, ".") {
+		namespace = ns
+	}
+	name = fullName(name, namespace)
+	namespace = name[:strings.LastIndex(name, ".")+1]
+	namespace = strings.TrimSuffix(namespace, ".")
+
+	s := &avroSchema{kind: typ, name: name}
+	if typ == "error" {
+		s.kind = "record"
+	}
+	for _, alias := range stringList(v["aliases"]) {
+		s.aliases = append(s.aliases, fullName(alias, namespace))
+	}
+	// Register the type before its fields, which may refer to it.
+	p.names[name] = s
+
+	switch typ {
+	case "record", "error":
+		fields, ok := v["fields"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("record %s has no fields", name)
+		}
+		for _, f := range fields {
+			field, ok := f.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("record %s has an invalid field %v", name, f)
+			}
+			fieldName, _ := field["name"].(string)
+			if fieldName == "" {
+				return nil, fmt.Errorf("record %s has a field without name", name)
+			}
+			fieldType, err := p.parse(field["type"], namespace)
+			if err != nil {
+				return nil, fmt.Errorf("field %s.%s: %w", name, fieldName, err)
+			}
+			_, hasDefault := field["default"]
+			s.fields = append(s.fields, avroField{
+				name:       fieldName,
+				aliases:    stringList(field["aliases"]),
+				typ:        fieldType,
+				hasDefault: hasDefault,
+			})
+		}
+	case "enum":
+		s.symbols = stringList(v["symbols"])
+		_, s.fallback = v["default"]
+	case "fixed":
+		size, ok := v["size"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("fixed %s has no size", name)
+		}
+		s.size = int(size)
+	}
+	return s, nil
+}
+
+func fullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func shortName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func stringList(v interface{}) []string {
+	list, _ := v.([]interface{})
+	strs := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+	return strs
+}
+
+func (s *avroSchema) canRead(writer parsedSchema) []issue {
+	c := &avroChecker{seen: make(map[[2]*avroSchema]bool)}
+	c.check(s, writer.(*avroSchema), "")
+	return c.issues
+}
+
+// avroChecker applies the Avro schema resolution rules.
+type avroChecker struct {
+	seen   map[[2]*avroSchema]bool
+	issues []issue
+}
+
+func (c *avroChecker) fail(path, format string, args ...interface{}) {
+	if path == "" {
+		path = "/"
+	}
+	c.issues = append(c.issues, issue{path: path, message: fmt.Sprintf(format, args...)})
+}
+
+func (c *avroChecker) check(reader, writer *avroSchema, path string) {
+	pair := [2]*avroSchema{reader, writer}
+	if c.seen[pair] {
+		return
+	}
+	c.seen[pair] = true
+
+	switch {
+	case writer.kind == "union":
+		// Data of every writer branch must be readable.
+		for _, branch := range writer.branches {
+			c.check(reader, branch, path)
+		}
+		return
+	case reader.kind == "union":
+		branch := reader.branchFor(writer)
+		if branch == nil {
+			c.fail(path, "reader union lacks writer type %s", writer)
+			return
+		}
+		c.check(branch, writer, path)
+		return
+	case !reader.matches(writer):
+		c.fail(path, "reader type %s cannot read writer type %s", reader, writer)
+		return
+	}
+
+	switch reader.kind {
+	case "record":
+		c.checkRecord(reader, writer, path)
+	case "enum":
+		if reader.fallback {
+			return
+		}
+		for _, symbol := range writer.symbols {
+			if !contains(reader.symbols, symbol) {
+				c.fail(path, "reader enum %s lacks writer symbol %s and has no default", reader.name, symbol)
+			}
+		}
+	case "fixed":
+		if reader.size != writer.size {
+			c.fail(path, "fixed %s changed size from %d to %d", reader.name, writer.size, reader.size)
+		}
+	case "array":
+		c.check(reader.items, writer.items, path+"/items")
+	case "map":
+		c.check(reader.values, writer.values, path+"/values")
+	}
+}
+
+func (c *avroChecker) checkRecord(reader, writer *avroSchema, path string) {
+	for _, field := range reader.fields {
+		fieldPath := path + "/fields/" + field.name
+		writerField := writer.field(field)
+		if writerField == nil {
+			if !field.hasDefault {
+				c.fail(fieldPath, "reader field %s has no default and is missing from the writer record %s", field.name, writer.name)
+			}
+			continue
+		}
+		c.check(field.typ, writerField.typ, fieldPath)
+	}
+}
+
+// field returns the field of the record s that readerField reads, matched
+// by name or alias.
+func (s *avroSchema) field(readerField avroField) *avroField {
+	for i, f := range s.fields {
+		if f.name == readerField.name || contains(readerField.aliases, f.name) {
+			return &s.fields[i]
+		}
+	}
+	return nil
+}
+
+// matches reports whether the reader type s can read writer, ignoring the
+// contents of named and complex types.
+func (s *avroSchema) matches(writer *avroSchema) bool {
+	if s.kind != writer.kind {
+		return contains(avroPromotions[s.kind], writer.kind)
+	}
+	if s.name == "" {
+		return true
+	}
+	if shortName(s.name) == shortName(writer.name) {
+		return true
+	}
+	for _, alias := range s.aliases {
+		if alias == writer.name || shortName(alias) == shortName(writer.name) {
+			return true
+		}
+	}
+	return false
+}
+
+// branchFor returns the branch of the reader union s that reads writer: the
+// first of the same type, else the first it can be promoted to.
+func (s *avroSchema) branchFor(writer *avroSchema) *avroSchema {
+	for _, branch := range s.branches {
+		if branch.kind == writer.kind && branch.matches(writer) {
+			return branch
+		}
+	}
+	for _, branch := range s.branches {
+		if branch.matches(writer) {
+			return branch
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+// Package schemacompat checks the compatibility of Avro, Protobuf and JSON
+// schemas offline, following the rules Schema Registry applies when a
+// schema is registered. It lets CI reject incompatible schema changes
+// without access to a registry:
+//
+//	report, err := schemacompat.Check(confluentcloud.CompatibilityBackward, schema, previous)
+//	if err != nil {
+//		...
+//	}
+//	if !report.Compatible() {
+//		fmt.Println(report)
+//	}
+//
+// A schema is checked as a reader of the data written with a previous
+// version (BACKWARD) and as a writer of data read with a previous version
+// (FORWARD).
+//
+// Schema references are not resolved: only the text of each schema is
+// checked. A type defined in a referenced schema, such as an Avro named type
+// or a message of a Protobuf import, is compared by its name only, so
+// changes inside it go unnoticed. A JSON Schema $ref to another document
+// makes the check fail.
+package schemacompat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// Incompatibility is a change that breaks compatibility with a previous
+// version.
+type Incompatibility struct {
+	// Version is the previous version the schema is incompatible with.
+	Version int
+	// Direction is CompatibilityBackward if data written with the previous
+	// version cannot be read with the schema, CompatibilityForward if data
+	// written with the schema cannot be read with the previous version.
+	Direction confluentcloud.CompatibilityLevel
+	// Path locates the change, e.g. /fields/id for an Avro field,
+	// #/properties/id for a JSON property or Order.id for a Protobuf field.
+	Path    string
+	Message string
+}
+
+func (i Incompatibility) String() string {
+	if i.Path == "" {
+		return fmt.Sprintf("version %d (%s): %s", i.Version, i.Direction, i.Message)
+	}
+	return fmt.Sprintf("version %d (%s): %s: %s", i.Version, i.Direction, i.Path, i.Message)
+}
+
+// Report is the outcome of a compatibility check.
+type Report struct {
+	Level             confluentcloud.CompatibilityLevel
+	Incompatibilities []Incompatibility
+}
+
+// Compatible reports whether the check found no incompatibilities.
+func (r *Report) Compatible() bool {
+	return len(r.Incompatibilities) == 0
+}
+
+// String describes the outcome of the check, one incompatibility per line.
+func (r *Report) String() string {
+	if r.Compatible() {
+		return fmt.Sprintf("schema is %s compatible", r.Level)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "schema is not %s compatible:", r.Level)
+	for _, i := range r.Incompatibilities {
+		b.WriteString("\n  ")
+		b.WriteString(i.String())
+	}
+	return b.String()
+}
+
+// Messages returns the incompatibilities as strings, like the messages of a
+// confluentcloud.CompatibilityResult.
+func (r *Report) Messages() []string {
+	messages := make([]string, len(r.Incompatibilities))
+	for i, incompatibility := range r.Incompatibilities {
+		messages[i] = incompatibility.String()
+	}
+	return messages
+}
+
+// Check checks schema against the previous versions of its subject, oldest
+// first, under level. Previous versions without a Version are numbered by
+// their position. It returns an error if a schema cannot be parsed. The
+// References of the schemas are ignored.
+func Check(level confluentcloud.CompatibilityLevel, schema confluentcloud.Schema, previous []confluentcloud.Schema) (*Report, error) {
+	report := &Report{Level: level}
+
+	var backward, forward, transitive bool
+	switch level {
+	case confluentcloud.CompatibilityNone:
+		return report, nil
+	case confluentcloud.CompatibilityBackward:
+		backward = true
+	case confluentcloud.CompatibilityBackwardTransitive:
+		backward, transitive = true, true
+	case confluentcloud.CompatibilityForward:
+		forward = true
+	case confluentcloud.CompatibilityForwardTransitive:
+		forward, transitive = true, true
+	case confluentcloud.CompatibilityFull:
+		backward, forward = true, true
+	case confluentcloud.CompatibilityFullTransitive:
+		backward, forward, transitive = true, true, true
+	default:
+		return nil, fmt.Errorf("unknown compatibility level %q", level)
+	}
+
+	parsed, err := parse(schema)
+	if err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+
+	first := 0
+	if !transitive && len(previous) > 0 {
+		first = len(previous) - 1
+	}
+	for i := first; i < len(previous); i++ {
+		version := previous[i].Version
+		if version == 0 {
+			version = i + 1
+		}
+
+		old, err := parse(previous[i])
+		if err != nil {
+			return nil, fmt.Errorf("parse version %d: %w", version, err)
+		}
+
+		add := func(direction confluentcloud.CompatibilityLevel, issues []issue) {
+			for _, issue := range issues {
+				report.Incompatibilities = append(report.Incompatibilities, Incompatibility{
+					Version:   version,
+					Direction: direction,
+					Path:      issue.path,
+					Message:   issue.message,
+				})
+			}
+		}
+
+		if parsed.schemaType() != old.schemaType() {
+			add(level, []issue{{message: fmt.Sprintf("schema type changed from %s to %s", old.schemaType(), parsed.schemaType())}})
+			continue
+		}
+		if backward {
+			add(confluentcloud.CompatibilityBackward, parsed.canRead(old))
+		}
+		if forward {
+			add(confluentcloud.CompatibilityForward, old.canRead(parsed))
+		}
+	}
+	return report, nil
+}
+
+// CheckSubject checks schema against the versions registered under subject,
+// under the compatibility level that applies to the subject. Only the
+// versions are fetched from the registry; the check itself runs locally.
+// Referenced schemas are not fetched, see the package documentation.
+func CheckSubject(ctx context.Context, client *confluentcloud.SchemaRegistryClient, subject string, schema confluentcloud.Schema) (*Report, error) {
+	level, err := client.Config.GetSubjectCompatibilityContext(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := client.Schemas.ListVersionsContext(ctx, subject, false)
+	if err != nil {
+		if confluentcloud.IsNotFound(err) {
+			return &Report{Level: level}, nil
+		}
+		return nil, err
+	}
+
+	previous := make([]confluentcloud.Schema, 0, len(versions))
+	for _, version := range versions {
+		s, err := client.Schemas.GetSchemaContext(ctx, subject, version)
+		if err != nil {
+			return nil, err
+		}
+		previous = append(previous, *s)
+	}
+	return Check(level, schema, previous)
+}
+
+// issue is an incompatibility found by a format specific check.
+type issue struct {
+	path    string
+	message string
+}
+
+// parsedSchema is a schema of one of the supported formats.
+type parsedSchema interface {
+	schemaType() confluentcloud.SchemaType
+	// canRead returns the reasons data written with writer, a schema of the
+	// same type, cannot be read with the schema.
+	canRead(writer parsedSchema) []issue
+}
+
+// parse parses the text of schema. schema.References is not used, so types
+// from referenced schemas stay unresolved.
+func parse(schema confluentcloud.Schema) (parsedSchema, error) {
+	switch schema.SchemaType {
+	case "", confluentcloud.SchemaTypeAvro:
+		return parseAvro(schema.Schema)
+	case confluentcloud.SchemaTypeJSON:
+		return parseJSONSchema(schema.Schema)
+	case confluentcloud.SchemaTypeProtobuf:
+		return parseProtobuf(schema.Schema)
+	}
+	return nil, fmt.Errorf("unknown schema type %q", schema.SchemaType)
+}
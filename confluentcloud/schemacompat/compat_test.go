@@ -0,0 +1,171 @@
+package schemacompat_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/schemacompat"
+)
+
+const (
+	avroOrder          = `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}]}`
+	avroOrderNote      = `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "note", "type": "string", "default": ""}]}`
+	avroOrderAmount    = `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "amount", "type": "int"}]}`
+	avroOrderAmountD   = `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "amount", "type": "int", "default": 0}]}`
+	avroOrderAmountL   = `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "amount", "type": "long"}]}`
+	protoOrder         = `syntax = "proto3"; message Order { string id = 1; }`
+	protoOrderItem     = `syntax = "proto3"; message Order { string id = 1; } message Item { string sku = 1; }`
+	protoOrderInt      = `syntax = "proto3"; message Order { int64 id = 1; }`
+	protoOrderCount    = `syntax = "proto3"; message Order { string id = 1; int32 count = 2; }`
+	protoOrderCount64  = `syntax = "proto3"; message Order { string id = 1; int64 count = 2; }`
+	protoOrderCountHex = `syntax = "proto3"; message Order { string id = 0x1; string count = 02; }`
+	jsonString         = `{"type": "string"}`
+	jsonNullable       = `{"type": ["string", "null"]}`
+	jsonShort          = `{"type": "string", "maxLength": 10}`
+	jsonLong           = `{"type": "string", "maxLength": 20}`
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		schemaType confluentcloud.SchemaType
+		level      confluentcloud.CompatibilityLevel
+		previous   []string
+		schema     string
+		compatible bool
+	}{
+		{"avro optional field added backward", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityBackward, []string{avroOrder}, avroOrderNote, true},
+		{"avro optional field added full", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityFull, []string{avroOrder}, avroOrderNote, true},
+		{"avro required field added backward", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityBackward, []string{avroOrder}, avroOrderAmount, false},
+		{"avro required field added forward", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityForward, []string{avroOrder}, avroOrderAmount, true},
+		{"avro required field removed backward", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityBackward, []string{avroOrderAmount}, avroOrder, true},
+		{"avro required field removed forward", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityForward, []string{avroOrderAmount}, avroOrder, false},
+		{"avro promotion backward", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityBackward, []string{avroOrderAmount}, avroOrderAmountL, true},
+		{"avro promotion full", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityFull, []string{avroOrderAmount}, avroOrderAmountL, false},
+		{"avro backward checks latest only", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityBackward, []string{avroOrder, avroOrderAmountD}, avroOrderAmount, true},
+		{"avro backward transitive", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityBackwardTransitive, []string{avroOrder, avroOrderAmountD}, avroOrderAmount, false},
+		{"avro forward checks latest only", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityForward, []string{avroOrderAmount, avroOrderAmountD}, avroOrder, true},
+		{"avro forward transitive", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityForwardTransitive, []string{avroOrderAmount, avroOrderAmountD}, avroOrder, false},
+		{"avro full transitive", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityFullTransitive, []string{avroOrder, avroOrderNote}, avroOrderNote, true},
+		{"avro none", confluentcloud.SchemaTypeAvro, confluentcloud.CompatibilityNone, []string{avroOrder}, avroOrderAmount, true},
+
+		{"protobuf message added backward", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityBackward, []string{protoOrder}, protoOrderItem, true},
+		{"protobuf message added forward", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityForward, []string{protoOrder}, protoOrderItem, false},
+		{"protobuf message removed backward", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityBackward, []string{protoOrderItem}, protoOrder, false},
+		{"protobuf message removed forward", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityForward, []string{protoOrderItem}, protoOrder, true},
+		{"protobuf field added full", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityFull, []string{protoOrder}, protoOrderCount, true},
+		{"protobuf wire compatible type full", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityFull, []string{protoOrderCount}, protoOrderCount64, true},
+		{"protobuf type changed backward", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityBackward, []string{protoOrder}, protoOrderInt, false},
+		{"protobuf backward transitive", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityBackwardTransitive, []string{protoOrderItem, protoOrder}, protoOrder, false},
+		{"protobuf forward transitive", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityForwardTransitive, []string{protoOrder, protoOrderItem}, protoOrderItem, false},
+		{"protobuf full transitive", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityFullTransitive, []string{protoOrder, protoOrderCount}, protoOrderCount64, true},
+		{"protobuf hex and octal field numbers", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityBackward, []string{protoOrderCount}, protoOrderCountHex, false},
+		{"protobuf none", confluentcloud.SchemaTypeProtobuf, confluentcloud.CompatibilityNone, []string{protoOrder}, protoOrderInt, true},
+
+		{"json type widened backward", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityBackward, []string{jsonString}, jsonNullable, true},
+		{"json type widened forward", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityForward, []string{jsonString}, jsonNullable, false},
+		{"json limit lowered backward", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityBackward, []string{jsonLong}, jsonShort, false},
+		{"json limit lowered forward", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityForward, []string{jsonLong}, jsonShort, true},
+		{"json unchanged full", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityFull, []string{jsonShort}, jsonShort, true},
+		{"json type widened full", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityFull, []string{jsonString}, jsonNullable, false},
+		{"json backward transitive", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityBackwardTransitive, []string{jsonNullable, jsonString}, jsonString, false},
+		{"json forward transitive", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityForwardTransitive, []string{jsonString, jsonNullable}, jsonNullable, false},
+		{"json full transitive", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityFullTransitive, []string{jsonString, jsonString}, jsonString, true},
+		{"json none", confluentcloud.SchemaTypeJSON, confluentcloud.CompatibilityNone, []string{jsonLong}, jsonShort, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := make([]confluentcloud.Schema, len(tt.previous))
+			for i, text := range tt.previous {
+				previous[i] = confluentcloud.Schema{Schema: text, SchemaType: tt.schemaType}
+			}
+			report, err := schemacompat.Check(tt.level, confluentcloud.Schema{Schema: tt.schema, SchemaType: tt.schemaType}, previous)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.Compatible() != tt.compatible {
+				t.Errorf("got %s, want compatible: %v", report, tt.compatible)
+			}
+		})
+	}
+}
+
+func TestCheckReportsDirectionAndVersion(t *testing.T) {
+	previous := []confluentcloud.Schema{{Schema: avroOrder, Version: 3}}
+	report, err := schemacompat.Check(confluentcloud.CompatibilityFull, confluentcloud.Schema{Schema: avroOrderAmount}, previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Incompatibilities) != 1 {
+		t.Fatalf("got %s, want one incompatibility", report)
+	}
+	got := report.Incompatibilities[0]
+	if got.Version != 3 || got.Direction != confluentcloud.CompatibilityBackward || got.Path != "/fields/amount" {
+		t.Errorf("got %+v, want a backward incompatibility of /fields/amount with version 3", got)
+	}
+}
+
+func TestCheckSchemaTypeChanged(t *testing.T) {
+	previous := []confluentcloud.Schema{{Schema: avroOrder}}
+	report, err := schemacompat.Check(confluentcloud.CompatibilityBackward, confluentcloud.Schema{Schema: jsonString, SchemaType: confluentcloud.SchemaTypeJSON}, previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Compatible() {
+		t.Error("got a compatible report after the schema type changed")
+	}
+}
+
+func TestCheckErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    confluentcloud.CompatibilityLevel
+		schema   confluentcloud.Schema
+		previous []confluentcloud.Schema
+		want     string
+	}{
+		{"unknown level", "SIDEWAYS", confluentcloud.Schema{Schema: avroOrder}, nil, "unknown compatibility level"},
+		{"unknown schema type", confluentcloud.CompatibilityBackward, confluentcloud.Schema{Schema: avroOrder, SchemaType: "XML"}, nil, "unknown schema type"},
+		{"malformed avro", confluentcloud.CompatibilityBackward, confluentcloud.Schema{Schema: `{"type": "record"`}, nil, "parse schema"},
+		{"avro record without name", confluentcloud.CompatibilityBackward, confluentcloud.Schema{Schema: `{"type": "record", "fields": []}`}, nil, "has no name"},
+		{"json array", confluentcloud.CompatibilityBackward, confluentcloud.Schema{Schema: `[]`, SchemaType: confluentcloud.SchemaTypeJSON}, nil, "must be an object or a boolean"},
+		{"malformed protobuf", confluentcloud.CompatibilityBackward, confluentcloud.Schema{Schema: `message Order { string id = one; }`, SchemaType: confluentcloud.SchemaTypeProtobuf}, nil, "parse schema"},
+		{"malformed previous version", confluentcloud.CompatibilityBackward, confluentcloud.Schema{Schema: avroOrder}, []confluentcloud.Schema{{Schema: "{"}}, "parse version 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := schemacompat.Check(tt.level, tt.schema, tt.previous)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckComparesReferencedTypesByName(t *testing.T) {
+	order := func(item string) confluentcloud.Schema {
+		return confluentcloud.Schema{
+			Schema:     `{"type": "record", "name": "Order", "fields": [{"name": "item", "type": "` + item + `"}]}`,
+			References: []confluentcloud.SchemaReference{{Name: item, Subject: "item-value", Version: 1}},
+		}
+	}
+
+	report, err := schemacompat.Check(confluentcloud.CompatibilityFull, order("com.example.Item"), []confluentcloud.Schema{order("com.example.Item")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Compatible() {
+		t.Errorf("got %s for the same reference, want compatible", report)
+	}
+
+	report, err = schemacompat.Check(confluentcloud.CompatibilityFull, order("com.example.Product"), []confluentcloud.Schema{order("com.example.Item")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Compatible() {
+		t.Error("got a compatible report after the referenced type was renamed")
+	}
+}
@@ -0,0 +1,439 @@
+package schemacompat
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// jsonSchema is a parsed JSON Schema. Subschemas are kept as decoded JSON:
+// booleans or objects.
+type jsonSchema struct {
+	root interface{}
+}
+
+// jsonAnnotations are keywords that do not restrict the accepted values.
+var jsonAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "id": true, "$comment": true, "title": true,
+	"description": true, "default": true, "examples": true, "definitions": true,
+	"$defs": true, "readOnly": true, "writeOnly": true, "deprecated": true,
+}
+
+func (s *jsonSchema) schemaType() confluentcloud.SchemaType {
+	return confluentcloud.SchemaTypeJSON
+}
+
+func parseJSONSchema(text string) (*jsonSchema, error) {
+	var root interface{}
+	if err := json.Unmarshal([]byte(text), &root); err != nil {
+		return nil, err
+	}
+	switch root.(type) {
+	case bool, map[string]interface{}:
+		return &jsonSchema{root: root}, nil
+	}
+	return nil, fmt.Errorf("schema must be an object or a boolean")
+}
+
+func (s *jsonSchema) canRead(writer parsedSchema) []issue {
+	c := &jsonChecker{
+		readerRoot: s.root,
+		writerRoot: writer.(*jsonSchema).root,
+		seen:       make(map[string]bool),
+	}
+	c.check(s.root, c.writerRoot, "#")
+	return c.issues
+}
+
+// jsonChecker checks that a reader schema accepts every value a writer
+// schema accepts.
+type jsonChecker struct {
+	readerRoot interface{}
+	writerRoot interface{}
+	seen       map[string]bool
+	issues     []issue
+}
+
+func (c *jsonChecker) fail(path, format string, args ...interface{}) {
+	c.issues = append(c.issues, issue{path: path, message: fmt.Sprintf(format, args...)})
+}
+
+// compatible reports whether reader accepts every value of writer, without
+// recording the issues.
+func (c *jsonChecker) compatible(reader, writer interface{}, path string) bool {
+	sub := &jsonChecker{readerRoot: c.readerRoot, writerRoot: c.writerRoot, seen: c.seen}
+	sub.check(reader, writer, path)
+	return len(sub.issues) == 0
+}
+
+func (c *jsonChecker) check(reader, writer interface{}, path string) {
+	readerRef, writerRef := ref(reader), ref(writer)
+	if readerRef != "" || writerRef != "" {
+		key := readerRef + "|" + writerRef
+		if c.seen[key] {
+			return
+		}
+		c.seen[key] = true
+		defer delete(c.seen, key)
+
+		var err error
+		if reader, err = resolveRef(c.readerRoot, reader); err != nil {
+			c.fail(path, "reader: %v", err)
+			return
+		}
+		if writer, err = resolveRef(c.writerRoot, writer); err != nil {
+			c.fail(path, "writer: %v", err)
+			return
+		}
+	}
+
+	if acceptsAll(reader) || acceptsNothing(writer) {
+		return
+	}
+	if acceptsNothing(reader) {
+		c.fail(path, "reader accepts no values")
+		return
+	}
+	r, w := object(reader), object(writer)
+
+	if c.checkCombinations(r, w, path) {
+		return
+	}
+	c.checkTypes(r, w, path)
+	c.checkEnum(r, w, path)
+	c.checkBounds(r, w, path)
+	if hasType(r, "object") || r["properties"] != nil {
+		c.checkObject(r, w, path)
+	}
+	if hasType(r, "array") || r["items"] != nil {
+		c.checkArray(r, w, path)
+	}
+}
+
+// checkCombinations handles oneOf, anyOf and allOf. It returns true if the
+// combinations decided the outcome.
+func (c *jsonChecker) checkCombinations(r, w map[string]interface{}, path string) bool {
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if alternatives, ok := w[keyword].([]interface{}); ok {
+			// Every value matches one of the writer's alternatives.
+			for i, alternative := range alternatives {
+				c.check(r, alternative, fmt.Sprintf("%s/%s/%d", path, keyword, i))
+			}
+			return true
+		}
+	}
+
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if alternatives, ok := r[keyword].([]interface{}); ok {
+			for i, alternative := range alternatives {
+				if c.compatible(alternative, w, fmt.Sprintf("%s/%s/%d", path, keyword, i)) {
+					return true
+				}
+			}
+			c.fail(path, "no %s alternative of the reader accepts the writer's values", keyword)
+			return true
+		}
+	}
+
+	if conjuncts, ok := r["allOf"].([]interface{}); ok {
+		for i, conjunct := range conjuncts {
+			c.check(conjunct, w, fmt.Sprintf("%s/allOf/%d", path, i))
+		}
+		return true
+	}
+	if conjuncts, ok := w["allOf"].([]interface{}); ok {
+		// The writer's values match every conjunct, so it suffices that
+		// the reader accepts the values of one.
+		for i, conjunct := range conjuncts {
+			if c.compatible(r, conjunct, fmt.Sprintf("%s/allOf/%d", path, i)) {
+				return true
+			}
+		}
+		c.fail(path, "reader does not accept the values of any allOf conjunct of the writer")
+		return true
+	}
+	return false
+}
+
+func (c *jsonChecker) checkTypes(r, w map[string]interface{}, path string) {
+	readerTypes := types(r)
+	if readerTypes == nil {
+		return
+	}
+	writerTypes := types(w)
+	if writerTypes == nil {
+		c.fail(path, "reader restricts the type to %s, writer accepts any type", strings.Join(readerTypes, ", "))
+		return
+	}
+	for _, t := range writerTypes {
+		if !contains(readerTypes, t) && !(t == "integer" && contains(readerTypes, "number")) {
+			c.fail(path, "reader does not accept type %s", t)
+		}
+	}
+}
+
+func (c *jsonChecker) checkEnum(r, w map[string]interface{}, path string) {
+	readerValues, ok := enum(r)
+	if !ok {
+		return
+	}
+	writerValues, ok := enum(w)
+	if !ok {
+		c.fail(path, "reader restricts the values to an enum, writer does not")
+		return
+	}
+	for _, v := range writerValues {
+		found := false
+		for _, accepted := range readerValues {
+			if reflect.DeepEqual(v, accepted) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.fail(path, "reader enum lacks writer value %v", v)
+		}
+	}
+}
+
+// checkBounds checks that the reader's limits are no narrower than the
+// writer's.
+func (c *jsonChecker) checkBounds(r, w map[string]interface{}, path string) {
+	upper := []string{"maxLength", "maximum", "exclusiveMaximum", "maxItems", "maxProperties"}
+	for _, keyword := range upper {
+		limit, ok := number(r[keyword])
+		if !ok {
+			continue
+		}
+		if writerLimit, ok := number(w[keyword]); !ok || writerLimit > limit {
+			c.fail(path, "reader lowered %s to %v", keyword, limit)
+		}
+	}
+
+	lower := []string{"minLength", "minimum", "exclusiveMinimum", "minItems", "minProperties"}
+	for _, keyword := range lower {
+		limit, ok := number(r[keyword])
+		if !ok {
+			continue
+		}
+		if writerLimit, ok := number(w[keyword]); !ok || writerLimit < limit {
+			c.fail(path, "reader raised %s to %v", keyword, limit)
+		}
+	}
+
+	for _, keyword := range []string{"pattern", "format", "multipleOf"} {
+		if r[keyword] != nil && !reflect.DeepEqual(r[keyword], w[keyword]) {
+			c.fail(path, "reader changed %s to %v", keyword, r[keyword])
+		}
+	}
+}
+
+func (c *jsonChecker) checkObject(r, w map[string]interface{}, path string) {
+	writerRequired := stringList(w["required"])
+	for _, name := range stringList(r["required"]) {
+		if !contains(writerRequired, name) {
+			c.fail(path+"/required", "reader requires property %s, writer does not", name)
+		}
+	}
+
+	readerProperties, _ := r["properties"].(map[string]interface{})
+	writerProperties, _ := w["properties"].(map[string]interface{})
+	readerAdditional, writerAdditional := additional(r), additional(w)
+
+	for _, name := range sortedKeys(writerProperties) {
+		propertyPath := path + "/properties/" + escapePointer(name)
+		if property, ok := readerProperties[name]; ok {
+			c.check(property, writerProperties[name], propertyPath)
+		} else if acceptsNothing(readerAdditional) {
+			c.fail(propertyPath, "property %s was removed from a closed content model", name)
+		} else {
+			c.check(readerAdditional, writerProperties[name], propertyPath)
+		}
+	}
+
+	for _, name := range sortedKeys(readerProperties) {
+		if _, ok := writerProperties[name]; ok {
+			continue
+		}
+		// The writer may have written the property as an additional one.
+		propertyPath := path + "/properties/" + escapePointer(name)
+		if acceptsAll(writerAdditional) && !acceptsAll(readerProperties[name]) {
+			c.fail(propertyPath, "property %s was added, but the writer's content model is open", name)
+		} else {
+			c.check(readerProperties[name], writerAdditional, propertyPath)
+		}
+	}
+
+	if !acceptsNothing(writerAdditional) {
+		c.check(readerAdditional, writerAdditional, path+"/additionalProperties")
+	}
+}
+
+func (c *jsonChecker) checkArray(r, w map[string]interface{}, path string) {
+	readerItems, ok := r["items"]
+	if !ok {
+		return
+	}
+	writerItems, ok := w["items"]
+	if !ok {
+		writerItems = true
+	}
+
+	readerTuple, readerIsTuple := readerItems.([]interface{})
+	writerTuple, writerIsTuple := writerItems.([]interface{})
+	switch {
+	case !readerIsTuple && !writerIsTuple:
+		c.check(readerItems, writerItems, path+"/items")
+	case readerIsTuple && writerIsTuple:
+		for i := range readerTuple {
+			itemPath := fmt.Sprintf("%s/items/%d", path, i)
+			if i < len(writerTuple) {
+				c.check(readerTuple[i], writerTuple[i], itemPath)
+			} else {
+				c.check(readerTuple[i], additionalItems(w), itemPath)
+			}
+		}
+	default:
+		c.fail(path+"/items", "items changed between a single schema and a tuple")
+	}
+}
+
+// ref returns the $ref of a schema, if any.
+func ref(schema interface{}) string {
+	s, _ := object(schema)["$ref"].(string)
+	return s
+}
+
+// resolveRef follows the local $ref of schema to its target in root.
+// Schemas without a $ref are returned as they are.
+func resolveRef(root, schema interface{}) (interface{}, error) {
+	for i := 0; i < 32; i++ {
+		target := ref(schema)
+		if target == "" {
+			return schema, nil
+		}
+		if !strings.HasPrefix(target, "#") {
+			return nil, fmt.Errorf("cannot resolve non-local $ref %s", target)
+		}
+
+		schema = root
+		pointer := strings.TrimPrefix(target, "#")
+		for _, token := range strings.Split(pointer, "/")[1:] {
+			token, err := url.PathUnescape(token)
+			if err != nil {
+				return nil, fmt.Errorf("invalid $ref %s", target)
+			}
+			token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+			switch node := schema.(type) {
+			case map[string]interface{}:
+				schema = node[token]
+			case []interface{}:
+				n, err := strconv.Atoi(token)
+				if err != nil || n < 0 || n >= len(node) {
+					return nil, fmt.Errorf("unresolved $ref %s", target)
+				}
+				schema = node[n]
+			default:
+				schema = nil
+			}
+			if schema == nil {
+				return nil, fmt.Errorf("unresolved $ref %s", target)
+			}
+		}
+	}
+	return nil, fmt.Errorf("too many nested $refs")
+}
+
+func object(schema interface{}) map[string]interface{} {
+	m, _ := schema.(map[string]interface{})
+	return m
+}
+
+// acceptsAll reports whether schema accepts every value: it is true, or an
+// object without restricting keywords.
+func acceptsAll(schema interface{}) bool {
+	switch s := schema.(type) {
+	case bool:
+		return s
+	case map[string]interface{}:
+		for keyword := range s {
+			if !jsonAnnotations[keyword] {
+				return false
+			}
+		}
+		return true
+	}
+	return schema == nil
+}
+
+func acceptsNothing(schema interface{}) bool {
+	b, ok := schema.(bool)
+	return ok && !b
+}
+
+// additional returns the additionalProperties of an object schema, true if
+// absent.
+func additional(schema map[string]interface{}) interface{} {
+	if a, ok := schema["additionalProperties"]; ok {
+		return a
+	}
+	return true
+}
+
+func additionalItems(schema map[string]interface{}) interface{} {
+	if a, ok := schema["additionalItems"]; ok {
+		return a
+	}
+	return true
+}
+
+// types returns the types a schema accepts, or nil if it does not restrict
+// them.
+func types(schema map[string]interface{}) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		return stringList(t)
+	}
+	return nil
+}
+
+func hasType(schema map[string]interface{}, t string) bool {
+	return contains(types(schema), t)
+}
+
+// enum returns the values a schema is restricted to by enum or const.
+func enum(schema map[string]interface{}) ([]interface{}, bool) {
+	if values, ok := schema["enum"].([]interface{}); ok {
+		return values, true
+	}
+	if value, ok := schema["const"]; ok {
+		return []interface{}{value}, true
+	}
+	return nil, false
+}
+
+func number(v interface{}) (float64, bool) {
+	n, ok := v.(float64)
+	return n, ok
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func escapePointer(token string) string {
+	return strings.Replace(strings.Replace(token, "~", "~0", -1), "/", "~1", -1)
+}
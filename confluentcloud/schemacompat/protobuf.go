@@ -0,0 +1,535 @@
+package schemacompat
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// protoSchema is a parsed .proto file. Messages and enums are keyed by their
+// name relative to the package, e.g. Order.Item for a nested message.
+type protoSchema struct {
+	syntax   string
+	pkg      string
+	messages map[string]*protoMessage
+	enums    map[string]bool
+}
+
+type protoMessage struct {
+	name   string
+	fields []*protoField
+	oneofs map[string]bool
+}
+
+type protoField struct {
+	name   string
+	number int
+	label  string
+	oneof  string
+	// typ is the type as written; kind and resolved are set once all types
+	// are known.
+	typ      string
+	kind     string
+	resolved string
+}
+
+const (
+	protoKindScalar  = "scalar"
+	protoKindMessage = "message"
+	protoKindEnum    = "enum"
+	protoKindMap     = "map"
+)
+
+var protoScalars = map[string]bool{
+	"double": true, "float": true, "int32": true, "int64": true, "uint32": true,
+	"uint64": true, "sint32": true, "sint64": true, "fixed32": true,
+	"fixed64": true, "sfixed32": true, "sfixed64": true, "bool": true,
+	"string": true, "bytes": true,
+}
+
+// protoWireGroups assigns scalar types to groups whose values can be read as
+// one another on the wire.
+var protoWireGroups = map[string]string{
+	"int32": "varint", "uint32": "varint", "int64": "varint", "uint64": "varint", "bool": "varint",
+	"sint32": "zigzag", "sint64": "zigzag",
+	"fixed32": "fixed32", "sfixed32": "fixed32",
+	"fixed64": "fixed64", "sfixed64": "fixed64",
+	"string": "length", "bytes": "length",
+}
+
+func (s *protoSchema) schemaType() confluentcloud.SchemaType {
+	return confluentcloud.SchemaTypeProtobuf
+}
+
+func parseProtobuf(text string) (*protoSchema, error) {
+	tokens, err := tokenizeProto(text)
+	if err != nil {
+		return nil, err
+	}
+	p := &protoParser{
+		tokens: tokens,
+		schema: &protoSchema{
+			syntax:   "proto2",
+			messages: make(map[string]*protoMessage),
+			enums:    make(map[string]bool),
+		},
+	}
+	if err := p.parseFile(); err != nil {
+		return nil, err
+	}
+	p.schema.resolve()
+	return p.schema, nil
+}
+
+type protoToken struct {
+	text string
+	line int
+}
+
+func tokenizeProto(text string) ([]protoToken, error) {
+	var tokens []protoToken
+	line := 1
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(text[i:], "//"):
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(text[i:], "/*"):
+			end := strings.Index(text[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(text[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(text) && text[j] != c {
+				if text[j] == '\\' {
+					j++
+				}
+				if j < len(text) && text[j] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated string", line)
+				}
+				j++
+			}
+			if j >= len(text) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			tokens = append(tokens, protoToken{text: text[i : j+1], line: line})
+			i = j + 1
+		case isProtoIdent(rune(c)) || c == '.':
+			j := i
+			for j < len(text) && (isProtoIdent(rune(text[j])) || text[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, protoToken{text: text[i:j], line: line})
+			i = j
+		default:
+			tokens = append(tokens, protoToken{text: string(c), line: line})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+func isProtoIdent(r rune) bool {
+	return r == '_' || r == '-' || r == '+' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+type protoParser struct {
+	tokens []protoToken
+	pos    int
+	schema *protoSchema
+}
+
+func (p *protoParser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	p.pos++
+	return p.tokens[p.pos-1].text
+}
+
+func (p *protoParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos].text
+}
+
+func (p *protoParser) errorf(format string, args ...interface{}) error {
+	line := 0
+	if p.pos > 0 && p.pos <= len(p.tokens) {
+		line = p.tokens[p.pos-1].line
+	}
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *protoParser) expect(want string) error {
+	if got := p.next(); got != want {
+		if got == "" {
+			return p.errorf("expected %q, found end of file", want)
+		}
+		return p.errorf("expected %q, found %q", want, got)
+	}
+	return nil
+}
+
+// skipStatement skips to the end of the current statement.
+func (p *protoParser) skipStatement() error {
+	depth := 0
+	for {
+		switch p.next() {
+		case "":
+			return p.errorf("unexpected end of file")
+		case "[", "{", "(":
+			depth++
+		case "]", "}", ")":
+			depth--
+		case ";":
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// skipBlock skips a declaration up to and including its closing brace.
+func (p *protoParser) skipBlock() error {
+	for p.peek() != "{" {
+		if p.next() == "" {
+			return p.errorf("unexpected end of file")
+		}
+	}
+	depth := 0
+	for {
+		switch p.next() {
+		case "":
+			return p.errorf("unexpected end of file")
+		case "{":
+			depth++
+		case "}":
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+func (p *protoParser) parseFile() error {
+	for p.pos < len(p.tokens) {
+		var err error
+		switch keyword := p.next(); keyword {
+		case ";":
+		case "syntax", "edition":
+			if err = p.expect("="); err != nil {
+				return err
+			}
+			p.schema.syntax = unquote(p.next())
+			err = p.expect(";")
+		case "package":
+			p.schema.pkg = p.next()
+			err = p.expect(";")
+		case "import", "option":
+			err = p.skipStatement()
+		case "message":
+			err = p.parseMessage("")
+		case "enum":
+			err = p.parseEnum("")
+		case "service", "extend":
+			err = p.skipBlock()
+		default:
+			return p.errorf("unexpected %q", keyword)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *protoParser) parseEnum(scope string) error {
+	p.schema.enums[scope+p.next()] = true
+	return p.skipBlock()
+}
+
+func (p *protoParser) parseMessage(scope string) error {
+	name := scope + p.next()
+	message := &protoMessage{name: name, oneofs: make(map[string]bool)}
+	p.schema.messages[name] = message
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	return p.parseBody(message, "")
+}
+
+// parseBody parses the declarations of a message or, if oneof is set, of a
+// oneof, up to the closing brace.
+func (p *protoParser) parseBody(message *protoMessage, oneof string) error {
+	for {
+		var err error
+		switch keyword := p.peek(); keyword {
+		case "":
+			return p.errorf("unexpected end of file")
+		case "}":
+			p.next()
+			return nil
+		case ";":
+			p.next()
+		case "option", "reserved", "extensions":
+			err = p.skipStatement()
+		case "extend":
+			err = p.skipBlock()
+		case "message":
+			p.next()
+			err = p.parseMessage(message.name + ".")
+		case "enum":
+			p.next()
+			err = p.parseEnum(message.name + ".")
+		case "oneof":
+			p.next()
+			name := p.next()
+			message.oneofs[name] = true
+			if err = p.expect("{"); err == nil {
+				err = p.parseBody(message, name)
+			}
+		default:
+			err = p.parseField(message, oneof)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (p *protoParser) parseField(message *protoMessage, oneof string) error {
+	field := &protoField{oneof: oneof}
+	switch p.peek() {
+	case "optional", "required", "repeated":
+		field.label = p.next()
+	}
+
+	field.typ = p.next()
+	if field.typ == "map" && p.peek() == "<" {
+		p.next()
+		key := p.next()
+		if err := p.expect(","); err != nil {
+			return err
+		}
+		value := p.next()
+		if err := p.expect(">"); err != nil {
+			return err
+		}
+		field.typ = "map<" + key + "," + value + ">"
+	}
+	if field.typ == "group" {
+		return p.errorf("groups are not supported")
+	}
+
+	field.name = p.next()
+	if err := p.expect("="); err != nil {
+		return err
+	}
+	// Field numbers may be decimal, hexadecimal or octal.
+	number, err := strconv.ParseInt(p.next(), 0, 32)
+	if err != nil {
+		return p.errorf("invalid number of field %s", field.name)
+	}
+	field.number = int(number)
+	if p.peek() != ";" {
+		// Skip the field options.
+		if err := p.skipStatement(); err != nil {
+			return err
+		}
+	} else {
+		p.next()
+	}
+
+	message.fields = append(message.fields, field)
+	return nil
+}
+
+// resolve sets the kind and resolved type of every field.
+func (s *protoSchema) resolve() {
+	for _, message := range s.messages {
+		for _, field := range message.fields {
+			if strings.HasPrefix(field.typ, "map<") {
+				inner := strings.TrimSuffix(strings.TrimPrefix(field.typ, "map<"), ">")
+				parts := strings.SplitN(inner, ",", 2)
+				_, value := s.lookup(parts[1], message.name)
+				field.kind = protoKindMap
+				field.resolved = "map<" + parts[0] + "," + value + ">"
+				continue
+			}
+			field.kind, field.resolved = s.lookup(field.typ, message.name)
+		}
+	}
+}
+
+// lookup resolves a type name used in scope following the protobuf scoping
+// rules. Types that are not declared in the file, e.g. imported ones, are
+// kept as written.
+func (s *protoSchema) lookup(typ, scope string) (kind, name string) {
+	if protoScalars[typ] {
+		return protoKindScalar, typ
+	}
+
+	var candidates []string
+	if strings.HasPrefix(typ, ".") {
+		candidates = []string{strings.TrimPrefix(typ, ".")}
+	} else {
+		for scope != "" {
+			candidates = append(candidates, scope+"."+typ)
+			if i := strings.LastIndex(scope, "."); i >= 0 {
+				scope = scope[:i]
+			} else {
+				scope = ""
+			}
+		}
+		candidates = append(candidates, typ)
+	}
+
+	for _, candidate := range candidates {
+		if s.pkg != "" {
+			candidate = strings.TrimPrefix(candidate, s.pkg+".")
+		}
+		if s.messages[candidate] != nil {
+			return protoKindMessage, candidate
+		}
+		if s.enums[candidate] {
+			return protoKindEnum, candidate
+		}
+	}
+	return protoKindMessage, strings.TrimPrefix(typ, ".")
+}
+
+func (s *protoSchema) canRead(writer parsedSchema) []issue {
+	w := writer.(*protoSchema)
+	var issues []issue
+	fail := func(path, format string, args ...interface{}) {
+		issues = append(issues, issue{path: path, message: fmt.Sprintf(format, args...)})
+	}
+
+	if s.syntax != w.syntax {
+		fail("", "syntax changed from %s to %s", w.syntax, s.syntax)
+	}
+	if s.pkg != w.pkg {
+		fail("", "package changed from %q to %q", w.pkg, s.pkg)
+	}
+
+	names := make([]string, 0, len(w.messages))
+	for name := range w.messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		reader := s.messages[name]
+		if reader == nil {
+			fail(name, "message was removed")
+			continue
+		}
+		for _, i := range reader.canRead(w.messages[name]) {
+			fail(i.path, "%s", i.message)
+		}
+	}
+	return issues
+}
+
+func (m *protoMessage) field(number int) *protoField {
+	for _, field := range m.fields {
+		if field.number == number {
+			return field
+		}
+	}
+	return nil
+}
+
+func (m *protoMessage) canRead(writer *protoMessage) []issue {
+	var issues []issue
+	fail := func(field *protoField, format string, args ...interface{}) {
+		issues = append(issues, issue{path: m.name + "." + field.name, message: fmt.Sprintf(format, args...)})
+	}
+
+	// movedToNewOneof counts the fields moved into each oneof the writer
+	// does not have.
+	movedToNewOneof := make(map[string]int)
+	for _, w := range writer.fields {
+		r := m.field(w.number)
+		if r == nil {
+			if w.oneof != "" && m.oneofs[w.oneof] {
+				fail(w, "field %d was removed from oneof %s", w.number, w.oneof)
+			}
+			continue
+		}
+
+		switch {
+		case r.kind != w.kind:
+			fail(r, "field %d changed from a %s to a %s", w.number, w.kind, r.kind)
+		case r.kind == protoKindScalar && r.resolved != w.resolved:
+			if protoWireGroups[r.resolved] == "" || protoWireGroups[r.resolved] != protoWireGroups[w.resolved] {
+				fail(r, "field %d changed type from %s to %s", w.number, w.resolved, r.resolved)
+			}
+		case r.resolved != w.resolved:
+			fail(r, "field %d changed type from %s to %s", w.number, w.resolved, r.resolved)
+		}
+
+		if (r.label == "repeated") != (w.label == "repeated") && (r.kind == protoKindEnum || isNumeric(r)) {
+			fail(r, "field %d changed between repeated and singular", w.number)
+		}
+
+		if r.oneof != "" && w.oneof == "" {
+			if writer.oneofs[r.oneof] {
+				fail(r, "field %d was moved into existing oneof %s", w.number, r.oneof)
+			} else {
+				movedToNewOneof[r.oneof]++
+			}
+		}
+	}
+
+	for _, r := range m.fields {
+		if r.label == "required" {
+			if w := writer.field(r.number); w == nil || w.label != "required" {
+				fail(r, "required field %d was added", r.number)
+			}
+		}
+	}
+
+	oneofs := make([]string, 0, len(movedToNewOneof))
+	for oneof := range movedToNewOneof {
+		oneofs = append(oneofs, oneof)
+	}
+	sort.Strings(oneofs)
+	for _, oneof := range oneofs {
+		if movedToNewOneof[oneof] > 1 {
+			issues = append(issues, issue{
+				path:    m.name,
+				message: fmt.Sprintf("%d fields were moved into new oneof %s", movedToNewOneof[oneof], oneof),
+			})
+		}
+	}
+	return issues
+}
+
+// isNumeric reports whether a field has a scalar type that can be packed.
+func isNumeric(field *protoField) bool {
+	return field.kind == protoKindScalar && field.resolved != "string" && field.resolved != "bytes"
+}
+
+func unquote(s string) string {
+	if u, err := strconv.Unquote(s); err == nil {
+		return u
+	}
+	return strings.Trim(s, `"'`)
+}
@@ -1,7 +1,7 @@
 package confluentcloud
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 )
 
@@ -33,6 +33,10 @@ type ServiceAccountDeleteRequest struct {
 }
 
 func (c *Client) CreateServiceAccount(request *ServiceAccountCreateRequest) (*ServiceAccount, error) {
+	return c.CreateServiceAccountContext(context.Background(), request)
+}
+
+func (c *Client) CreateServiceAccountContext(ctx context.Context, request *ServiceAccountCreateRequest) (*ServiceAccount, error) {
 	rel, err := url.Parse("service_accounts")
 	if err != nil {
 		return nil, err
@@ -41,9 +45,9 @@ func (c *Client) CreateServiceAccount(request *ServiceAccountCreateRequest) (*Se
 	u := c.BaseURL.ResolveReference(rel)
 
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetBody(&ServiceAccountCreateRequestW{ServiceAccount: request}).
 		SetResult(&ServiceAccountResponse{}).
-		SetError(&ErrorResponse{}).
 		Post(u.String())
 
 	if err != nil {
@@ -51,13 +55,17 @@ func (c *Client) CreateServiceAccount(request *ServiceAccountCreateRequest) (*Se
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("service_accounts: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("service_accounts", response)
 	}
 
 	return &response.Result().(*ServiceAccountResponse).ServiceAccount, nil
 }
 
 func (c *Client) ListServiceAccounts() ([]ServiceAccount, error) {
+	return c.ListServiceAccountsContext(context.Background())
+}
+
+func (c *Client) ListServiceAccountsContext(ctx context.Context) ([]ServiceAccount, error) {
 	rel, err := url.Parse("service_accounts")
 	if err != nil {
 		return []ServiceAccount{}, err
@@ -65,8 +73,8 @@ func (c *Client) ListServiceAccounts() ([]ServiceAccount, error) {
 
 	u := c.BaseURL.ResolveReference(rel)
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetResult(&ServiceAccountsResponse{}).
-		SetError(&ErrorResponse{}).
 		Get(u.String())
 
 	if err != nil {
@@ -74,12 +82,52 @@ func (c *Client) ListServiceAccounts() ([]ServiceAccount, error) {
 	}
 
 	if response.IsError() {
-		return []ServiceAccount{}, fmt.Errorf("service_accounts: %s", response.Error().(*ErrorResponse).Error.Message)
+		return []ServiceAccount{}, newAPIError("service_accounts", response)
 	}
 	return response.Result().(*ServiceAccountsResponse).ServiceAccounts, nil
 }
 
+// ServiceAccountIterator iterates over the service accounts. The v1 API returns the
+// complete list, which is requested by the first call to Next.
+type ServiceAccountIterator struct {
+	pager
+	page    []ServiceAccount
+	current ServiceAccount
+}
+
+// Next advances to the next service account. It returns false at the end of the
+// list or when the request failed; check Err to tell them apart.
+func (it *ServiceAccountIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// ServiceAccount returns the service account Next advanced to.
+func (it *ServiceAccountIterator) ServiceAccount() ServiceAccount {
+	return it.current
+}
+
+// IterateServiceAccounts returns an iterator over the service accounts.
+func (c *Client) IterateServiceAccounts(ctx context.Context) *ServiceAccountIterator {
+	it := &ServiceAccountIterator{}
+	it.pager = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		var err error
+		it.page, err = c.ListServiceAccountsContext(ctx)
+		return "", err
+	}}
+	return it
+}
+
 func (c *Client) DeleteServiceAccount(id int) error {
+	return c.DeleteServiceAccountContext(context.Background(), id)
+}
+
+func (c *Client) DeleteServiceAccountContext(ctx context.Context, id int) error {
 	rel, err := url.Parse("service_accounts")
 	if err != nil {
 		return err
@@ -92,7 +140,7 @@ func (c *Client) DeleteServiceAccount(id int) error {
 	}
 
 	response, err := c.NewRequest().
-		SetError(&ErrorResponse{}).
+		SetContext(ctx).
 		SetBody(&ServiceAccountDeleteRequestW{ServiceAccount: request}).
 		Delete(u.String())
 
@@ -101,7 +149,7 @@ func (c *Client) DeleteServiceAccount(id int) error {
 	}
 
 	if response.IsError() {
-		return fmt.Errorf("delete service account: %s", response.Error().(*ErrorResponse).Error.Message)
+		return newAPIError("delete service account", response)
 	}
 
 	return nil
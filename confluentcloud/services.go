@@ -0,0 +1,221 @@
+package confluentcloud
+
+import (
+	"context"
+)
+
+// The services below group the operations of a Client by resource. Client,
+// or KafkaClient and SchemaRegistryClient for the resources inside a cluster
+// or registry, implements them and exposes itself through its fields of the
+// service types, so code can depend on the narrow interface it needs and
+// tests can substitute a fake, e.g. from the confluentcloudmock package.
+
+// ClustersService manages Kafka clusters.
+type ClustersService interface {
+	ListClusters(accountID string) ([]Cluster, error)
+	ListClustersContext(ctx context.Context, accountID string) ([]Cluster, error)
+	IterateClusters(ctx context.Context, accountID string) *ClusterIterator
+	CreateCluster(request ClusterCreateConfig) (*Cluster, error)
+	CreateClusterContext(ctx context.Context, request ClusterCreateConfig) (*Cluster, error)
+	DeleteCluster(id, accountID string) error
+	DeleteClusterContext(ctx context.Context, id, accountID string) error
+	GetCluster(id, accountID string) (*Cluster, error)
+	GetClusterContext(ctx context.Context, id, accountID string) (*Cluster, error)
+	UpdateCluster(id, accountID, name string) error
+	UpdateClusterContext(ctx context.Context, id, accountID, name string) error
+	UpdateClusterConfig(id, accountID string, update ClusterUpdate) (*Cluster, error)
+	UpdateClusterConfigContext(ctx context.Context, id, accountID string, update ClusterUpdate) (*Cluster, error)
+	WaitForCluster(id, accountID, status string, opts *WaitOptions) (*Cluster, error)
+	WaitForClusterContext(ctx context.Context, id, accountID, status string, opts *WaitOptions) (*Cluster, error)
+	WaitForClusterDeleted(id, accountID string, opts *WaitOptions) error
+	WaitForClusterDeletedContext(ctx context.Context, id, accountID string, opts *WaitOptions) error
+	WaitForClusterUpdate(id, accountID string, update ClusterUpdate, opts *WaitOptions) (*Cluster, error)
+	WaitForClusterUpdateContext(ctx context.Context, id, accountID string, update ClusterUpdate, opts *WaitOptions) (*Cluster, error)
+}
+
+// APIKeysService manages API keys of Kafka clusters.
+type APIKeysService interface {
+	CreateAPIKey(request *ApiKeyCreateRequest) (*APIKey, error)
+	CreateAPIKeyContext(ctx context.Context, request *ApiKeyCreateRequest) (*APIKey, error)
+	DeleteAPIKey(id, accountID string, logicalClusters []LogicalCluster) error
+	DeleteAPIKeyContext(ctx context.Context, id, accountID string, logicalClusters []LogicalCluster) error
+	ListAPIKeys(clusterID, accountID string) ([]APIKey, error)
+	ListAPIKeysContext(ctx context.Context, clusterID, accountID string) ([]APIKey, error)
+	IterateAPIKeys(ctx context.Context, clusterID, accountID string) *APIKeyIterator
+}
+
+// ServiceAccountsService manages service accounts.
+type ServiceAccountsService interface {
+	CreateServiceAccount(request *ServiceAccountCreateRequest) (*ServiceAccount, error)
+	CreateServiceAccountContext(ctx context.Context, request *ServiceAccountCreateRequest) (*ServiceAccount, error)
+	ListServiceAccounts() ([]ServiceAccount, error)
+	ListServiceAccountsContext(ctx context.Context) ([]ServiceAccount, error)
+	IterateServiceAccounts(ctx context.Context) *ServiceAccountIterator
+	DeleteServiceAccount(id int) error
+	DeleteServiceAccountContext(ctx context.Context, id int) error
+}
+
+// EnvironmentsService manages environments.
+type EnvironmentsService interface {
+	GetEnvironment(id string) (*Environment, error)
+	GetEnvironmentContext(ctx context.Context, id string) (*Environment, error)
+	ListEnvironments() ([]Environment, error)
+	ListEnvironmentsContext(ctx context.Context) ([]Environment, error)
+	IterateEnvironments(ctx context.Context) *EnvironmentIterator
+	CreateEnvironment(name string, organizationID int) (*Environment, error)
+	CreateEnvironmentContext(ctx context.Context, name string, organizationID int) (*Environment, error)
+	DeleteEnvironment(id string) error
+	DeleteEnvironmentContext(ctx context.Context, id string) error
+	UpdateEnvironment(id, newName string, organizationID int) (*Environment, error)
+	UpdateEnvironmentContext(ctx context.Context, id, newName string, organizationID int) (*Environment, error)
+}
+
+// ConnectorsService manages connectors of Kafka clusters.
+type ConnectorsService interface {
+	ListConnectors(accountID, clusterID string) ([]Connector, error)
+	ListConnectorsContext(ctx context.Context, accountID, clusterID string) ([]Connector, error)
+	IterateConnectors(ctx context.Context, accountID, clusterID string) *ConnectorIterator
+	CreateConnector(accountID, clusterID, name string, config ConnectorConfig) (*ConnectorInfo, error)
+	CreateConnectorContext(ctx context.Context, accountID, clusterID, name string, config ConnectorConfig) (*ConnectorInfo, error)
+	UpdateConnectorConfig(accountID, clusterID, name string, config ConnectorConfig) (*ConnectorInfo, error)
+	UpdateConnectorConfigContext(ctx context.Context, accountID, clusterID, name string, config ConnectorConfig) (*ConnectorInfo, error)
+	GetConnector(accountID, clusterID, name string) (*ConnectorInfo, error)
+	GetConnectorContext(ctx context.Context, accountID, clusterID, name string) (*ConnectorInfo, error)
+	DeleteConnector(accountID, clusterID, name string) error
+	DeleteConnectorContext(ctx context.Context, accountID, clusterID, name string) error
+	GetConnectorStatus(accountID, clusterID, name string) (*ConnectorStatus, error)
+	GetConnectorStatusContext(ctx context.Context, accountID, clusterID, name string) (*ConnectorStatus, error)
+	PauseConnector(accountID, clusterID, name string) error
+	PauseConnectorContext(ctx context.Context, accountID, clusterID, name string) error
+	ResumeConnector(accountID, clusterID, name string) error
+	ResumeConnectorContext(ctx context.Context, accountID, clusterID, name string) error
+	RestartConnector(accountID, clusterID, name string) error
+	RestartConnectorContext(ctx context.Context, accountID, clusterID, name string) error
+	RestartTask(accountID, clusterID string, task ConnectorTask) error
+	RestartTaskContext(ctx context.Context, accountID, clusterID string, task ConnectorTask) error
+	WaitForConnectorRunning(accountID, clusterID, name string, opts *WaitOptions) (*ConnectorStatus, error)
+	WaitForConnectorRunningContext(ctx context.Context, accountID, clusterID, name string, opts *WaitOptions) (*ConnectorStatus, error)
+	ListConnectorPlugins(accountID, clusterID string) ([]ConnectorPlugin, error)
+	ListConnectorPluginsContext(ctx context.Context, accountID, clusterID string) ([]ConnectorPlugin, error)
+	ValidateConnectorConfig(accountID, clusterID, plugin string, config ConnectorConfig) (*ConnectorConfigValidation, error)
+	ValidateConnectorConfigContext(ctx context.Context, accountID, clusterID, plugin string, config ConnectorConfig) (*ConnectorConfigValidation, error)
+}
+
+// SchemaRegistryService manages the schema registries of environments.
+type SchemaRegistryService interface {
+	GetSchemaRegistry(accountID string) (*SchemaRegistry, error)
+	GetSchemaRegistryContext(ctx context.Context, accountID string) (*SchemaRegistry, error)
+	ListSchemaRegistries(accountID string) ([]SchemaRegistry, error)
+	ListSchemaRegistriesContext(ctx context.Context, accountID string) ([]SchemaRegistry, error)
+	GetSchemaRegistryByID(id, accountID string) (*SchemaRegistry, error)
+	GetSchemaRegistryByIDContext(ctx context.Context, id, accountID string) (*SchemaRegistry, error)
+	CreateSchemaRegistry(accountID, location, serviceProvider string) (*SchemaRegistry, error)
+	CreateSchemaRegistryContext(ctx context.Context, accountID, location, serviceProvider string) (*SchemaRegistry, error)
+	CreateSchemaRegistryWithConfig(config SchemaRegistryCreateConfig) (*SchemaRegistry, error)
+	CreateSchemaRegistryWithConfigContext(ctx context.Context, config SchemaRegistryCreateConfig) (*SchemaRegistry, error)
+	DeleteSchemaRegistry(id, accountID string) error
+	DeleteSchemaRegistryContext(ctx context.Context, id, accountID string) error
+}
+
+// RoleBindingsService manages RBAC role bindings.
+type RoleBindingsService interface {
+	ListRoles() ([]Role, error)
+	ListRolesContext(ctx context.Context) ([]Role, error)
+	ListRoleBindings(filter RoleBindingFilter) ([]RoleBinding, error)
+	ListRoleBindingsContext(ctx context.Context, filter RoleBindingFilter) ([]RoleBinding, error)
+	IterateRoleBindings(ctx context.Context, filter RoleBindingFilter, opts *ListOptions) *RoleBindingIterator
+	CreateRoleBinding(principal, roleName string, scope RoleBindingScope) (*RoleBinding, error)
+	CreateRoleBindingContext(ctx context.Context, principal, roleName string, scope RoleBindingScope) (*RoleBinding, error)
+	DeleteRoleBinding(id string) error
+	DeleteRoleBindingContext(ctx context.Context, id string) error
+	EffectiveRoleBindings(principal string, scope RoleBindingScope) ([]RoleBinding, error)
+	EffectiveRoleBindingsContext(ctx context.Context, principal string, scope RoleBindingScope) ([]RoleBinding, error)
+}
+
+// SchemasService manages the subjects and schemas of a Schema Registry. It
+// is implemented by SchemaRegistryClient.
+type SchemasService interface {
+	ListSubjects(deleted bool) ([]string, error)
+	ListSubjectsContext(ctx context.Context, deleted bool) ([]string, error)
+	ListVersions(subject string, deleted bool) ([]int, error)
+	ListVersionsContext(ctx context.Context, subject string, deleted bool) ([]int, error)
+	GetSchema(subject string, version int) (*Schema, error)
+	GetSchemaContext(ctx context.Context, subject string, version int) (*Schema, error)
+	GetSchemaByID(id int) (*Schema, error)
+	GetSchemaByIDContext(ctx context.Context, id int) (*Schema, error)
+	RegisterSchema(subject string, schema *Schema) (int, error)
+	RegisterSchemaContext(ctx context.Context, subject string, schema *Schema) (int, error)
+	DeleteSchemaVersion(subject string, version int, permanent bool) error
+	DeleteSchemaVersionContext(ctx context.Context, subject string, version int, permanent bool) error
+	DeleteSubject(subject string, permanent bool) ([]int, error)
+	DeleteSubjectContext(ctx context.Context, subject string, permanent bool) ([]int, error)
+	TestCompatibility(subject string, version int, schema *Schema) (*CompatibilityResult, error)
+	TestCompatibilityContext(ctx context.Context, subject string, version int, schema *Schema) (*CompatibilityResult, error)
+}
+
+// SchemaConfigService manages the compatibility levels and modes of a Schema
+// Registry and its subjects. It is implemented by SchemaRegistryClient.
+type SchemaConfigService interface {
+	GetCompatibility() (CompatibilityLevel, error)
+	GetCompatibilityContext(ctx context.Context) (CompatibilityLevel, error)
+	SetCompatibility(level CompatibilityLevel) error
+	SetCompatibilityContext(ctx context.Context, level CompatibilityLevel) error
+	GetSubjectCompatibility(subject string) (CompatibilityLevel, error)
+	GetSubjectCompatibilityContext(ctx context.Context, subject string) (CompatibilityLevel, error)
+	SetSubjectCompatibility(subject string, level CompatibilityLevel) error
+	SetSubjectCompatibilityContext(ctx context.Context, subject string, level CompatibilityLevel) error
+	DeleteSubjectCompatibility(subject string) error
+	DeleteSubjectCompatibilityContext(ctx context.Context, subject string) error
+	GetMode() (SchemaRegistryMode, error)
+	GetModeContext(ctx context.Context) (SchemaRegistryMode, error)
+	SetMode(mode SchemaRegistryMode) error
+	SetModeContext(ctx context.Context, mode SchemaRegistryMode) error
+	GetSubjectMode(subject string) (SchemaRegistryMode, error)
+	GetSubjectModeContext(ctx context.Context, subject string) (SchemaRegistryMode, error)
+	SetSubjectMode(subject string, mode SchemaRegistryMode) error
+	SetSubjectModeContext(ctx context.Context, subject string, mode SchemaRegistryMode) error
+	DeleteSubjectMode(subject string) error
+	DeleteSubjectModeContext(ctx context.Context, subject string) error
+}
+
+// TopicsService manages the topics of a Kafka cluster. It is implemented by
+// KafkaClient.
+type TopicsService interface {
+	ListTopics() ([]Topic, error)
+	ListTopicsContext(ctx context.Context) ([]Topic, error)
+	GetTopic(name string) (*Topic, error)
+	GetTopicContext(ctx context.Context, name string) (*Topic, error)
+	CreateTopic(request *TopicCreateRequest) (*Topic, error)
+	CreateTopicContext(ctx context.Context, request *TopicCreateRequest) (*Topic, error)
+	DeleteTopic(name string) error
+	DeleteTopicContext(ctx context.Context, name string) error
+	GetTopicConfigs(name string) ([]TopicConfig, error)
+	GetTopicConfigsContext(ctx context.Context, name string) ([]TopicConfig, error)
+	AlterTopicConfigs(name string, changes []TopicConfigAlter) error
+	AlterTopicConfigsContext(ctx context.Context, name string, changes []TopicConfigAlter) error
+}
+
+// ACLsService manages the ACLs of a Kafka cluster. It is implemented by
+// KafkaClient.
+type ACLsService interface {
+	CreateACL(binding ACLBinding) error
+	CreateACLContext(ctx context.Context, binding ACLBinding) error
+	ListACLs(filter ACLFilter) ([]ACLBinding, error)
+	ListACLsContext(ctx context.Context, filter ACLFilter) ([]ACLBinding, error)
+	DeleteACLs(filter ACLFilter) ([]ACLBinding, error)
+	DeleteACLsContext(ctx context.Context, filter ACLFilter) ([]ACLBinding, error)
+}
+
+var (
+	_ ClustersService        = (*Client)(nil)
+	_ APIKeysService         = (*Client)(nil)
+	_ ServiceAccountsService = (*Client)(nil)
+	_ EnvironmentsService    = (*Client)(nil)
+	_ ConnectorsService      = (*Client)(nil)
+	_ SchemaRegistryService  = (*Client)(nil)
+	_ RoleBindingsService    = (*Client)(nil)
+	_ TopicsService          = (*KafkaClient)(nil)
+	_ ACLsService            = (*KafkaClient)(nil)
+	_ SchemasService         = (*SchemaRegistryClient)(nil)
+	_ SchemaConfigService    = (*SchemaRegistryClient)(nil)
+)
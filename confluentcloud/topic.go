@@ -0,0 +1,224 @@
+package confluentcloud
+
+import (
+	"context"
+)
+
+// Topic is a Kafka topic as returned by the Kafka REST API.
+type Topic struct {
+	Name              string `json:"topic_name"`
+	ClusterID         string `json:"cluster_id"`
+	Internal          bool   `json:"is_internal"`
+	ReplicationFactor int    `json:"replication_factor"`
+	PartitionsCount   int    `json:"partitions_count"`
+}
+
+type TopicsResponse struct {
+	Data []Topic `json:"data"`
+}
+
+// TopicCreateRequest describes a new topic. Zero PartitionsCount and
+// ReplicationFactor leave them to the cluster defaults.
+type TopicCreateRequest struct {
+	Name              string        `json:"topic_name"`
+	PartitionsCount   int           `json:"partitions_count,omitempty"`
+	ReplicationFactor int           `json:"replication_factor,omitempty"`
+	Configs           []ConfigEntry `json:"configs,omitempty"`
+}
+
+// ConfigEntry is the name and value of a topic config.
+type ConfigEntry struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// TopicConfig is a config of a topic, e.g. retention.ms.
+type TopicConfig struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// Source tells where the value comes from, e.g. DYNAMIC_TOPIC_CONFIG
+	// for a value set on the topic or DEFAULT_CONFIG for a default.
+	Source    string `json:"source"`
+	Default   bool   `json:"is_default"`
+	ReadOnly  bool   `json:"is_read_only"`
+	Sensitive bool   `json:"is_sensitive"`
+}
+
+type TopicConfigsResponse struct {
+	Data []TopicConfig `json:"data"`
+}
+
+// ConfigOperation is the operation of a TopicConfigAlter.
+type ConfigOperation string
+
+const (
+	// ConfigOperationSet sets a config to a value.
+	ConfigOperationSet ConfigOperation = "SET"
+	// ConfigOperationDelete removes a config from the topic, restoring its
+	// default.
+	ConfigOperationDelete ConfigOperation = "DELETE"
+)
+
+// TopicConfigAlter changes one topic config. An empty Operation sets it.
+type TopicConfigAlter struct {
+	Name      string          `json:"name"`
+	Value     string          `json:"value,omitempty"`
+	Operation ConfigOperation `json:"operation,omitempty"`
+}
+
+type TopicConfigsAlterRequest struct {
+	Data []TopicConfigAlter `json:"data"`
+}
+
+func (k *KafkaClient) ListTopics() ([]Topic, error) {
+	return k.ListTopicsContext(context.Background())
+}
+
+func (k *KafkaClient) ListTopicsContext(ctx context.Context) ([]Topic, error) {
+	u, err := k.url("topics")
+	if err != nil {
+		return []Topic{}, err
+	}
+
+	response, err := k.NewRequest(ctx).
+		SetResult(&TopicsResponse{}).
+		Get(u)
+
+	if err != nil {
+		return []Topic{}, err
+	}
+
+	if response.IsError() {
+		return []Topic{}, newAPIError("list topics", response)
+	}
+
+	return response.Result().(*TopicsResponse).Data, nil
+}
+
+func (k *KafkaClient) GetTopic(name string) (*Topic, error) {
+	return k.GetTopicContext(context.Background(), name)
+}
+
+func (k *KafkaClient) GetTopicContext(ctx context.Context, name string) (*Topic, error) {
+	u, err := k.url("topics/%s", name)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := k.NewRequest(ctx).
+		SetResult(&Topic{}).
+		Get(u)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, newAPIError("get topic", response)
+	}
+
+	return response.Result().(*Topic), nil
+}
+
+func (k *KafkaClient) CreateTopic(request *TopicCreateRequest) (*Topic, error) {
+	return k.CreateTopicContext(context.Background(), request)
+}
+
+func (k *KafkaClient) CreateTopicContext(ctx context.Context, request *TopicCreateRequest) (*Topic, error) {
+	u, err := k.url("topics")
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := k.NewRequest(ctx).
+		SetBody(request).
+		SetResult(&Topic{}).
+		Post(u)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, newAPIError("create topic", response)
+	}
+
+	return response.Result().(*Topic), nil
+}
+
+func (k *KafkaClient) DeleteTopic(name string) error {
+	return k.DeleteTopicContext(context.Background(), name)
+}
+
+func (k *KafkaClient) DeleteTopicContext(ctx context.Context, name string) error {
+	u, err := k.url("topics/%s", name)
+	if err != nil {
+		return err
+	}
+
+	response, err := k.NewRequest(ctx).
+		Delete(u)
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError("delete topic", response)
+	}
+
+	return nil
+}
+
+// GetTopicConfigs returns all configs of a topic, including defaults.
+func (k *KafkaClient) GetTopicConfigs(name string) ([]TopicConfig, error) {
+	return k.GetTopicConfigsContext(context.Background(), name)
+}
+
+func (k *KafkaClient) GetTopicConfigsContext(ctx context.Context, name string) ([]TopicConfig, error) {
+	u, err := k.url("topics/%s/configs", name)
+	if err != nil {
+		return []TopicConfig{}, err
+	}
+
+	response, err := k.NewRequest(ctx).
+		SetResult(&TopicConfigsResponse{}).
+		Get(u)
+
+	if err != nil {
+		return []TopicConfig{}, err
+	}
+
+	if response.IsError() {
+		return []TopicConfig{}, newAPIError("get topic configs", response)
+	}
+
+	return response.Result().(*TopicConfigsResponse).Data, nil
+}
+
+// AlterTopicConfigs applies all changes to the configs of a topic in one
+// request. Configs that are not mentioned keep their values.
+func (k *KafkaClient) AlterTopicConfigs(name string, changes []TopicConfigAlter) error {
+	return k.AlterTopicConfigsContext(context.Background(), name, changes)
+}
+
+func (k *KafkaClient) AlterTopicConfigsContext(ctx context.Context, name string, changes []TopicConfigAlter) error {
+	u, err := k.url("topics/%s/configs:alter", name)
+	if err != nil {
+		return err
+	}
+
+	response, err := k.NewRequest(ctx).
+		SetBody(&TopicConfigsAlterRequest{Data: changes}).
+		Post(u)
+
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return newAPIError("alter topic configs", response)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package confluentcloud_test
+
+import (
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud/confluentcloudtest"
+)
+
+// newKafkaClient creates a cluster on srv and returns a client for its Kafka
+// REST API.
+func newKafkaClient(t *testing.T, srv *confluentcloudtest.Server) *confluentcloud.KafkaClient {
+	t.Helper()
+	c := srv.NewClientWithAPIKey()
+	env := srv.DefaultEnvironmentID()
+
+	cluster, err := c.CreateCluster(confluentcloud.ClusterCreateConfig{
+		Name:            "orders",
+		AccountID:       env,
+		Region:          "us-west-2",
+		ServiceProvider: confluentcloud.ServiceProviderAWS,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := c.CreateAPIKey(&confluentcloud.ApiKeyCreateRequest{
+		AccountID:       env,
+		LogicalClusters: []confluentcloud.LogicalCluster{{ID: cluster.ID}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := c.NewKafkaClient(cluster, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func TestTopics(t *testing.T) {
+	srv := confluentcloudtest.NewServer()
+	defer srv.Close()
+	k := newKafkaClient(t, srv)
+
+	created, err := k.CreateTopic(&confluentcloud.TopicCreateRequest{
+		Name:    "orders",
+		Configs: []confluentcloud.ConfigEntry{{Name: "retention.ms", Value: "3600000"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ClusterID != k.ClusterID || created.PartitionsCount == 0 {
+		t.Errorf("got topic %+v, want one of %s with the default partitions", created, k.ClusterID)
+	}
+	if _, err := k.CreateTopic(&confluentcloud.TopicCreateRequest{Name: "payments", PartitionsCount: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.CreateTopic(&confluentcloud.TopicCreateRequest{Name: "orders"}); err == nil {
+		t.Error("got no error for a duplicate topic")
+	}
+
+	topics, err := k.ListTopics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(topics) != 2 || topics[0].Name != "orders" || topics[1].Name != "payments" {
+		t.Errorf("got topics %+v, want orders and payments", topics)
+	}
+
+	got, err := k.GetTopic("payments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.PartitionsCount != 3 {
+		t.Errorf("got %d partitions, want 3", got.PartitionsCount)
+	}
+
+	err = k.AlterTopicConfigs("orders", []confluentcloud.TopicConfigAlter{
+		{Name: "cleanup.policy", Value: "compact"},
+		{Name: "retention.ms", Operation: confluentcloud.ConfigOperationDelete},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	configs, err := k.GetTopicConfigs("orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]confluentcloud.TopicConfig{}
+	for _, config := range configs {
+		values[config.Name] = config
+	}
+	if policy := values["cleanup.policy"]; policy.Value != "compact" || policy.Default {
+		t.Errorf("got cleanup.policy %+v, want compact set on the topic", policy)
+	}
+	if retention := values["retention.ms"]; retention.Value != "604800000" || !retention.Default {
+		t.Errorf("got retention.ms %+v, want the default after the delete", retention)
+	}
+	if err := k.AlterTopicConfigs("orders", []confluentcloud.TopicConfigAlter{{Name: "unknown", Value: "1"}}); err == nil {
+		t.Error("got no error for an unknown config")
+	}
+
+	if err := k.DeleteTopic("orders"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.GetTopic("orders"); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v after delete, want not found", err)
+	}
+	if err := k.DeleteTopic("orders"); !confluentcloud.IsNotFound(err) {
+		t.Errorf("got %v deleting a missing topic, want not found", err)
+	}
+}
@@ -0,0 +1,83 @@
+package confluentcloud
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// transport is the http.RoundTripper used by Client. It waits for the rate
+// limiters and authenticates every request before handing it to the
+// underlying transport and, when the authenticator supports it, renews
+// rejected credentials and replays the request once.
+type transport struct {
+	client *Client
+	base   http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.client.waitForRateLimit(req); err != nil {
+		return nil, err
+	}
+
+	auth := t.client.Authenticator
+	if override, ok := authenticatorFromContext(req.Context()); ok {
+		auth = override
+	}
+	if auth == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	refresher, canRefresh := auth.(Refresher)
+
+	// The body is buffered so that it can be sent again after a refresh.
+	// resty replaces the GetBody that http.NewRequest derives from its
+	// body buffer with one that returns the unread part of that buffer,
+	// which is empty once the first attempt has been sent.
+	var body []byte
+	if canRefresh && req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sent, resp, err := t.send(req, auth, body)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !canRefresh {
+		return resp, err
+	}
+
+	drain(resp.Body)
+	if err := refresher.Refresh(sent); err != nil {
+		return nil, fmt.Errorf("refresh credentials: %w", err)
+	}
+
+	_, resp, err = t.send(req, auth, body)
+	return resp, err
+}
+
+// send authenticates a copy of req, as a RoundTripper must not modify the
+// request it was given, and sends it. A non-nil body replaces the body of req.
+func (t *transport) send(req *http.Request, auth Authenticator, body []byte) (*http.Request, *http.Response, error) {
+	req = req.Clone(req.Context())
+	if body != nil {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+	if err := auth.Authenticate(req); err != nil {
+		return nil, nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	return req, resp, err
+}
+
+func drain(body io.ReadCloser) {
+	io.Copy(ioutil.Discard, body)
+	body.Close()
+}
@@ -0,0 +1,176 @@
+package confluentcloud_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/cgroschupp/go-client-confluent-cloud/confluentcloud"
+)
+
+// sessionServer accepts only the token of the latest login, so that revoking
+// it makes the next request of a client fail with 401.
+type sessionServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	token       string
+	logins      int
+	rejectLogin bool
+	bodies      []string
+}
+
+func newSessionServer() *sessionServer {
+	s := &sessionServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *sessionServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == "/sessions" {
+		s.logins++
+		if s.rejectLogin {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":{"code":401,"message":"invalid credentials"}}`)
+			return
+		}
+		s.token = fmt.Sprintf("token-%d", s.logins)
+		fmt.Fprintf(w, `{"token":%q}`, s.token)
+		return
+	}
+
+	s.bodies = append(s.bodies, string(body))
+	if r.Header.Get("Authorization") != "Bearer "+s.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"code":401,"message":"Unauthorized"}}`)
+		return
+	}
+
+	var req confluentcloud.EnvironmentCreateRequest
+	json.Unmarshal(body, &req)
+	json.NewEncoder(w).Encode(confluentcloud.EnvironmentResponse{
+		Account: confluentcloud.Environment{ID: "env-1", Name: req.Account.Name},
+	})
+}
+
+// revoke invalidates the current session token.
+func (s *sessionServer) revoke() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = "revoked"
+}
+
+func (s *sessionServer) loginCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins
+}
+
+func (s *sessionServer) newClient(t *testing.T) *confluentcloud.Client {
+	u, _ := url.Parse(s.URL)
+	c := confluentcloud.NewClient("user@example.com", "password", confluentcloud.WithBaseURL(u))
+	if err := c.Login(); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestExpiredSessionReplaysRequestBody(t *testing.T) {
+	s := newSessionServer()
+	defer s.Close()
+	c := s.newClient(t)
+	s.revoke()
+
+	env, err := c.CreateEnvironment("production", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Name != "production" {
+		t.Errorf("got environment %q, want production", env.Name)
+	}
+	if got := s.loginCount(); got != 2 {
+		t.Errorf("got %d logins, want 2", got)
+	}
+
+	if len(s.bodies) != 2 || s.bodies[0] != s.bodies[1] || s.bodies[0] == "" {
+		t.Errorf("rejected and replayed bodies differ: %q", s.bodies)
+	}
+}
+
+func TestConcurrentExpiredSessionsLogInOnce(t *testing.T) {
+	s := newSessionServer()
+	defer s.Close()
+	c := s.newClient(t)
+	s.revoke()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			env, err := c.CreateEnvironment(fmt.Sprintf("env-%d", i), 1)
+			if err == nil && env.Name != fmt.Sprintf("env-%d", i) {
+				err = fmt.Errorf("got environment %q, want env-%d", env.Name, i)
+			}
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if got := s.loginCount(); got != 2 {
+		t.Errorf("got %d logins, want 2", got)
+	}
+}
+
+func TestFailedReloginReturnsError(t *testing.T) {
+	s := newSessionServer()
+	defer s.Close()
+	c := s.newClient(t)
+	s.revoke()
+	s.mu.Lock()
+	s.rejectLogin = true
+	s.mu.Unlock()
+
+	if _, err := c.GetEnvironment("env-1"); err == nil {
+		t.Fatal("got no error, want the error of the failed login")
+	}
+	if got := s.loginCount(); got != 2 {
+		t.Errorf("got %d logins, want 2", got)
+	}
+}
+
+func TestAPIKeyIsNotRefreshed(t *testing.T) {
+	s := newSessionServer()
+	defer s.Close()
+	u, _ := url.Parse(s.URL)
+	c := confluentcloud.NewClientWithAPIKey("key", "secret", confluentcloud.WithBaseURL(u))
+
+	_, err := c.GetEnvironment("env-1")
+	if !confluentcloud.IsUnauthorized(err) {
+		t.Fatalf("got %v, want an unauthorized error", err)
+	}
+	if got := s.loginCount(); got != 0 {
+		t.Errorf("got %d logins, want 0", got)
+	}
+	if len(s.bodies) != 1 {
+		t.Errorf("got %d requests, want 1", len(s.bodies))
+	}
+}
@@ -1,7 +1,7 @@
 package confluentcloud
 
 import (
-	"fmt"
+	"context"
 	"net/url"
 )
 
@@ -18,6 +18,10 @@ type UserInfoRequest struct {
 }
 
 func (c *Client) Me() (*UserInfoRequest, error) {
+	return c.MeContext(context.Background())
+}
+
+func (c *Client) MeContext(ctx context.Context) (*UserInfoRequest, error) {
 	rel, err := url.Parse("me")
 	if err != nil {
 		return nil, err
@@ -25,6 +29,7 @@ func (c *Client) Me() (*UserInfoRequest, error) {
 
 	u := c.BaseURL.ResolveReference(rel)
 	response, err := c.NewRequest().
+		SetContext(ctx).
 		SetResult(&UserInfoRequest{}).
 		Get(u.String())
 
@@ -33,7 +38,7 @@ func (c *Client) Me() (*UserInfoRequest, error) {
 	}
 
 	if response.IsError() {
-		return nil, fmt.Errorf("me: %s", response.Error().(*ErrorResponse).Error.Message)
+		return nil, newAPIError("me", response)
 	}
 
 	return response.Result().(*UserInfoRequest), nil
@@ -0,0 +1,170 @@
+package confluentcloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	ClusterStatusUp           = "UP"
+	ClusterStatusProvisioning = "PROVISIONING"
+	ClusterStatusDeleting     = "DELETING"
+	ClusterStatusFailed       = "FAILED"
+)
+
+// WaitOptions controls how the WaitFor helpers poll the API. The zero value
+// polls after 5s, then backs off by half the interval up to once a minute.
+type WaitOptions struct {
+	// Interval is the time before the first retry.
+	Interval time.Duration
+	// MaxInterval caps the time between two polls.
+	MaxInterval time.Duration
+	// Multiplier grows the interval after every poll. Use 1 to poll at a
+	// fixed interval.
+	Multiplier float64
+	// NotFoundGrace is how long WaitForConnectorRunning polls a connector
+	// that is not found before it returns the not found error. Zero allows
+	// a minute.
+	NotFoundGrace time.Duration
+}
+
+// ClusterFailedError is returned by WaitForCluster when the cluster reaches a
+// failure state it cannot leave.
+type ClusterFailedError struct {
+	ID     string
+	Status string
+}
+
+func (e *ClusterFailedError) Error() string {
+	return fmt.Sprintf("cluster %s failed with status %s", e.ID, e.Status)
+}
+
+// WaitForCluster polls the cluster until its Status is status, e.g.
+// ClusterStatusUp, and returns it. It fails with a *ClusterFailedError if the
+// cluster fails.
+func (c *Client) WaitForCluster(id, accountID, status string, opts *WaitOptions) (*Cluster, error) {
+	return c.WaitForClusterContext(context.Background(), id, accountID, status, opts)
+}
+
+// WaitForClusterContext is like WaitForCluster, but fails with the error of
+// ctx when ctx is done first.
+func (c *Client) WaitForClusterContext(ctx context.Context, id, accountID, status string, opts *WaitOptions) (*Cluster, error) {
+	var cluster *Cluster
+	err := poll(ctx, opts, func() (bool, error) {
+		var err error
+		cluster, err = c.GetClusterContext(ctx, id, accountID)
+		if err != nil {
+			return false, err
+		}
+		if cluster.Status == ClusterStatusFailed && status != ClusterStatusFailed {
+			return false, &ClusterFailedError{ID: id, Status: cluster.Status}
+		}
+		return cluster.Status == status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
+
+// WaitForConnectorRunning polls the status of a connector until it and all
+// of its tasks are running, and returns the status. It fails with a
+// *ConnectorFailedError if the connector or a task fails, and with a
+// *ConnectorPausedError if the connector is paused. Restart the failed
+// connector or task, or resume the connector, before waiting again. A
+// connector that is not known yet, e.g. right after CreateConnector, is
+// polled until it shows up or opts.NotFoundGrace has passed.
+func (c *Client) WaitForConnectorRunning(accountID, clusterID, name string, opts *WaitOptions) (*ConnectorStatus, error) {
+	return c.WaitForConnectorRunningContext(context.Background(), accountID, clusterID, name, opts)
+}
+
+// WaitForConnectorRunningContext is like WaitForConnectorRunning, but fails
+// with the error of ctx when ctx is done first.
+func (c *Client) WaitForConnectorRunningContext(ctx context.Context, accountID, clusterID, name string, opts *WaitOptions) (*ConnectorStatus, error) {
+	grace := time.Minute
+	if opts != nil && opts.NotFoundGrace > 0 {
+		grace = opts.NotFoundGrace
+	}
+	notFoundUntil := time.Now().Add(grace)
+
+	var status *ConnectorStatus
+	err := poll(ctx, opts, func() (bool, error) {
+		var err error
+		status, err = c.GetConnectorStatusContext(ctx, accountID, clusterID, name)
+		if IsNotFound(err) && time.Now().Before(notFoundUntil) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if err := status.failure(); err != nil {
+			return false, err
+		}
+		if status.Connector.State == ConnectorStatePaused {
+			return false, &ConnectorPausedError{Name: name}
+		}
+		return status.Running(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// WaitForClusterDeleted polls the cluster until the API no longer knows it,
+// e.g. after DeleteCluster.
+func (c *Client) WaitForClusterDeleted(id, accountID string, opts *WaitOptions) error {
+	return c.WaitForClusterDeletedContext(context.Background(), id, accountID, opts)
+}
+
+// WaitForClusterDeletedContext is like WaitForClusterDeleted, but fails with
+// the error of ctx when ctx is done first.
+func (c *Client) WaitForClusterDeletedContext(ctx context.Context, id, accountID string, opts *WaitOptions) error {
+	return poll(ctx, opts, func() (bool, error) {
+		_, err := c.GetClusterContext(ctx, id, accountID)
+		if IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+// poll calls done until it reports true or fails, sleeping between the calls
+// as configured by opts.
+func poll(ctx context.Context, opts *WaitOptions, done func() (bool, error)) error {
+	var o WaitOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval <= 0 {
+		o.Interval = 5 * time.Second
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = time.Minute
+	}
+	if o.Multiplier < 1 {
+		o.Multiplier = 1.5
+	}
+
+	interval := o.Interval
+	for {
+		ok, err := done()
+		if err != nil || ok {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+
+		interval = time.Duration(float64(interval) * o.Multiplier)
+		if interval > o.MaxInterval {
+			interval = o.MaxInterval
+		}
+	}
+}
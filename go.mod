@@ -1,5 +1,5 @@
 module github.com/cgroschupp/go-client-confluent-cloud
 
-go 1.12
+go 1.13
 
 require github.com/go-resty/resty/v2 v2.1.0